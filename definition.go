@@ -217,6 +217,10 @@ type ParseValueFn func(value interface{}) interface{}
 // ParseLiteralFn is a function type for parsing the literal value of a GraphQLScalar type
 type ParseLiteralFn func(valueAST ast.Value) interface{}
 
+// ValidateLiteralFn is a function type for explaining why a literal value is
+// invalid for a GraphQLScalar type, returning nil for valid ones
+type ValidateLiteralFn func(valueAST ast.Value) error
+
 // ScalarConfig options for creating a new GraphQLScalar
 type ScalarConfig struct {
 	Name         string `json:"name"`
@@ -224,6 +228,9 @@ type ScalarConfig struct {
 	Serialize    SerializeFn
 	ParseValue   ParseValueFn
 	ParseLiteral ParseLiteralFn
+	// ValidateLiteral optionally reports why a literal can't be parsed, so
+	// that validation errors carry the reason instead of a generic message.
+	ValidateLiteral ValidateLiteralFn
 }
 
 // NewScalar creates a new GraphQLScalar
@@ -286,6 +293,12 @@ func (st *Scalar) ParseLiteral(valueAST ast.Value) interface{} {
 	}
 	return st.scalarConfig.ParseLiteral(valueAST)
 }
+func (st *Scalar) ValidateLiteral(valueAST ast.Value) error {
+	if st.scalarConfig.ValidateLiteral == nil {
+		return nil
+	}
+	return st.scalarConfig.ValidateLiteral(valueAST)
+}
 func (st *Scalar) Name() string {
 	return st.PrivateName
 }
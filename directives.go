@@ -34,6 +34,15 @@ var SpecifiedDirectives = []*Directive{
 	DeprecatedDirective,
 }
 
+// IncrementalDeliveryDirectives The directives of incremental delivery,
+// @defer and @stream. They aren't specified directives: servers which support
+// incremental delivery add them to the directives of their schema, which
+// also enables StreamDirectiveOnListFieldRule.
+var IncrementalDeliveryDirectives = []*Directive{
+	DeferDirective,
+	StreamDirective,
+}
+
 // Directive structs are used by the GraphQL runtime as a way of modifying execution
 // behavior. Type system creators will usually not create these directly.
 type Directive struct {
@@ -41,6 +50,9 @@ type Directive struct {
 	Description string      `json:"description"`
 	Locations   []string    `json:"locations"`
 	Args        []*Argument `json:"args"`
+	// IsRepeatable allows the directive to be used more than once at the
+	// same location.
+	IsRepeatable bool `json:"isRepeatable"`
 
 	err error
 }
@@ -51,6 +63,9 @@ type DirectiveConfig struct {
 	Description string              `json:"description"`
 	Locations   []string            `json:"locations"`
 	Args        FieldConfigArgument `json:"args"`
+	// IsRepeatable allows the directive to be used more than once at the
+	// same location.
+	IsRepeatable bool `json:"isRepeatable"`
 }
 
 func NewDirective(config DirectiveConfig) *Directive {
@@ -89,6 +104,7 @@ func NewDirective(config DirectiveConfig) *Directive {
 	dir.Description = config.Description
 	dir.Locations = config.Locations
 	dir.Args = args
+	dir.IsRepeatable = config.IsRepeatable
 	return dir
 }
 
@@ -146,3 +162,54 @@ var DeprecatedDirective = NewDirective(DirectiveConfig{
 		DirectiveLocationEnumValue,
 	},
 })
+
+// DeferDirective Used to defer the delivery of a fragment, see
+// IncrementalDeliveryDirectives.
+var DeferDirective = NewDirective(DirectiveConfig{
+	Name: "defer",
+	Description: "Directs the executor to deliver this fragment after the rest of " +
+		"the response, unless the `if` argument is false.",
+	Locations: []string{
+		DirectiveLocationFragmentSpread,
+		DirectiveLocationInlineFragment,
+	},
+	Args: FieldConfigArgument{
+		"if": &ArgumentConfig{
+			Type:         Boolean,
+			Description:  "Deferred when true.",
+			DefaultValue: true,
+		},
+		"label": &ArgumentConfig{
+			Type:        String,
+			Description: "Identifies the deferred fragment in the subsequent payloads.",
+		},
+	},
+})
+
+// StreamDirective Used to stream the items of a list field, see
+// IncrementalDeliveryDirectives.
+var StreamDirective = NewDirective(DirectiveConfig{
+	Name: "stream",
+	Description: "Directs the executor to deliver the items of this list field " +
+		"past the `initialCount` first ones in subsequent payloads, unless the " +
+		"`if` argument is false.",
+	Locations: []string{
+		DirectiveLocationField,
+	},
+	Args: FieldConfigArgument{
+		"if": &ArgumentConfig{
+			Type:         Boolean,
+			Description:  "Streamed when true.",
+			DefaultValue: true,
+		},
+		"label": &ArgumentConfig{
+			Type:        String,
+			Description: "Identifies the streamed items in the subsequent payloads.",
+		},
+		"initialCount": &ArgumentConfig{
+			Type:         Int,
+			Description:  "The number of items delivered in the initial payload.",
+			DefaultValue: 0,
+		},
+	},
+})
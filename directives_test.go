@@ -144,6 +144,36 @@ func TestDirectives_DirectiveArgNamesMustBeValid(t *testing.T) {
 	}
 }
 
+func TestDirectives_ConditionalDirectivesRequireBooleanIf(t *testing.T) {
+	for _, directive := range []*graphql.Directive{graphql.IncludeDirective, graphql.SkipDirective} {
+		if len(directive.Args) != 1 || directive.Args[0].Name() != "if" {
+			t.Fatalf("Expected @%v to only take an if argument, got: %v", directive.Name, directive.Args)
+		}
+		if ttype := directive.Args[0].Type.String(); ttype != "Boolean!" {
+			t.Fatalf("Expected @%v(if:) to be of type Boolean!, got: %v", directive.Name, ttype)
+		}
+	}
+}
+
+func TestDirectives_DeprecatedIsSpecifiedWithStringReason(t *testing.T) {
+	specified := false
+	for _, directive := range graphql.SpecifiedDirectives {
+		if directive == graphql.DeprecatedDirective {
+			specified = true
+		}
+	}
+	if !specified {
+		t.Fatalf("Expected @deprecated to be a specified directive")
+	}
+	directive := graphql.DeprecatedDirective
+	if len(directive.Args) != 1 || directive.Args[0].Name() != "reason" {
+		t.Fatalf("Expected @deprecated to only take a reason argument, got: %v", directive.Args)
+	}
+	if ttype := directive.Args[0].Type.String(); ttype != "String" {
+		t.Fatalf("Expected @deprecated(reason:) to be of type String, got: %v", ttype)
+	}
+}
+
 func TestDirectivesWorksWithoutDirectives(t *testing.T) {
 	query := `{ a, b }`
 	expected := &graphql.Result{
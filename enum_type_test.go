@@ -179,7 +179,7 @@ func TestTypeSystem_EnumValues_DoesNotAcceptStringLiterals(t *testing.T) {
 		Data: nil,
 		Errors: []gqlerrors.FormattedError{
 			{
-				Message: "Argument \"fromEnum\" has invalid value \"GREEN\".\nExpected type \"Color\", found \"GREEN\".",
+				Message: "Argument \"fromEnum\" has invalid value \"GREEN\".\nEnum \"Color\" cannot represent non-enum value: \"GREEN\". Did you mean the enum value \"GREEN\"?",
 				Locations: []location.SourceLocation{
 					{Line: 1, Column: 23},
 				},
@@ -209,7 +209,8 @@ func TestTypeSystem_EnumValues_DoesNotAcceptInternalValueInPlaceOfEnumLiteral(t
 		Data: nil,
 		Errors: []gqlerrors.FormattedError{
 			{
-				Message: "Argument \"fromEnum\" has invalid value 1.\nExpected type \"Color\", found 1.",
+				Message: "Argument \"fromEnum\" has invalid value 1.\nEnum \"Color\" cannot represent non-enum value: 1. " +
+					"Did you mean the enum value \"BLUE\", \"GREEN\", or \"RED\"?",
 				Locations: []location.SourceLocation{
 					{Line: 1, Column: 23},
 				},
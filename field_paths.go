@@ -0,0 +1,130 @@
+package graphql
+
+import (
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/language/visitor"
+)
+
+// FieldPaths Returns the dotted paths of the fields selected by the operations
+// of the document, e.g. "query.user.friends.name", in the order they are first
+// selected.
+//
+// Paths start with the operation type and use response names, so aliased
+// fields appear under their alias. Fragments are expanded in place, except
+// those whose type condition can never apply to the enclosing selection.
+func FieldPaths(doc *ast.Document, schema *Schema) []string {
+	paths := []string{}
+	if doc == nil || schema == nil {
+		return paths
+	}
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, definition := range doc.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+	seen := map[string]bool{}
+	// The fragments being expanded, so that cycles end.
+	expanding := map[string]bool{}
+
+	var walk func(selectionSet *ast.SelectionSet, parentType Type, path []string)
+	walk = func(selectionSet *ast.SelectionSet, parentType Type, path []string) {
+		typeInfo := NewTypeInfo(&TypeInfoConfig{
+			Schema: schema,
+		})
+		// The selection set takes its parent type from the type of the
+		// enclosing field, so stand in for that field.
+		if ttype, ok := parentType.(Output); ok {
+			typeInfo.typeStack = append(typeInfo.typeStack, ttype)
+		}
+		applies := func(conditionType Type) bool {
+			enclosingType := typeInfo.ParentType()
+			if conditionType == nil || enclosingType == nil {
+				return true
+			}
+			return doTypesOverlap(schema, conditionType, enclosingType)
+		}
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.Field)
+						if !ok || node.Name == nil {
+							return visitor.ActionSkip, nil
+						}
+						responseName := node.Name.Value
+						if node.Alias != nil {
+							responseName = node.Alias.Value
+						}
+						path = append(path, responseName)
+						if fieldPath := strings.Join(path, "."); !seen[fieldPath] {
+							seen[fieldPath] = true
+							paths = append(paths, fieldPath)
+						}
+						return visitor.ActionNoChange, nil
+					},
+					Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+						path = path[:len(path)-1]
+						return visitor.ActionNoChange, nil
+					},
+				},
+				kinds.InlineFragment: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if node, ok := p.Node.(*ast.InlineFragment); ok && node.TypeCondition != nil && !applies(typeInfo.Type()) {
+							return visitor.ActionSkip, nil
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+				kinds.FragmentSpread: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.FragmentSpread)
+						if !ok || node.Name == nil {
+							return visitor.ActionNoChange, nil
+						}
+						fragment := fragments[node.Name.Value]
+						if fragment == nil || expanding[node.Name.Value] {
+							return visitor.ActionNoChange, nil
+						}
+						fragmentType, _ := typeFromAST(*schema, fragment.TypeCondition)
+						if !applies(fragmentType) {
+							return visitor.ActionNoChange, nil
+						}
+						expanding[node.Name.Value] = true
+						walk(fragment.SelectionSet, fragmentType, append([]string{}, path...))
+						delete(expanding, node.Name.Value)
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		// Visit in parallel so that the type info still enters and leaves the
+		// nodes the visitor skips.
+		visitor.Visit(selectionSet, visitor.VisitWithTypeInfo(typeInfo, visitor.VisitInParallel(visitorOpts)), nil)
+	}
+
+	for _, definition := range doc.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok || operation.SelectionSet == nil {
+			continue
+		}
+		var rootType *Object
+		switch operation.Operation {
+		case ast.OperationTypeQuery:
+			rootType = schema.QueryType()
+		case ast.OperationTypeMutation:
+			rootType = schema.MutationType()
+		case ast.OperationTypeSubscription:
+			rootType = schema.SubscriptionType()
+		}
+		var parentType Type
+		if rootType != nil {
+			parentType = rootType
+		}
+		walk(operation.SelectionSet, parentType, []string{operation.Operation})
+	}
+	return paths
+}
@@ -0,0 +1,73 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestFieldPaths(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        human {
+          name
+          ...HumanPets
+          relatives {
+            firstName: name
+          }
+        }
+        pet {
+          ... on Dog {
+            name
+            owner: barkVolume
+          }
+          ... on Human {
+            iq
+          }
+        }
+      }
+      fragment HumanPets on Human {
+        pets {
+          name
+          ... on Cat {
+            meowVolume
+          }
+        }
+      }
+    `)
+	expected := []string{
+		"query.human",
+		"query.human.name",
+		"query.human.pets",
+		"query.human.pets.name",
+		"query.human.pets.meowVolume",
+		"query.human.relatives",
+		"query.human.relatives.firstName",
+		"query.pet",
+		"query.pet.name",
+		"query.pet.owner",
+	}
+	if paths := graphql.FieldPaths(doc, testutil.TestSchema); !reflect.DeepEqual(expected, paths) {
+		t.Fatalf("Unexpected field paths, expected: %v, got: %v", expected, paths)
+	}
+}
+
+func TestFieldPaths_FragmentCycle(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      { human { ...HumanFields } }
+      fragment HumanFields on Human {
+        name
+        relatives { ...HumanFields }
+      }
+    `)
+	expected := []string{
+		"query.human",
+		"query.human.name",
+		"query.human.relatives",
+	}
+	if paths := graphql.FieldPaths(doc, testutil.TestSchema); !reflect.DeepEqual(expected, paths) {
+		t.Fatalf("Unexpected field paths, expected: %v, got: %v", expected, paths)
+	}
+}
@@ -18,6 +18,10 @@ type Error struct {
 	Locations     []location.SourceLocation
 	OriginalError error
 	Path          []interface{}
+	// Extensions holds structured data about the error for tooling, e.g. the
+	// name of an invalid argument. It takes precedence over the extensions of
+	// the original error when formatting.
+	Extensions map[string]interface{}
 }
 
 // implements Golang's built-in `error` interface
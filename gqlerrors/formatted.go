@@ -43,7 +43,9 @@ func FormatError(err error) FormattedError {
 			Path:          err.Path,
 			originalError: err,
 		}
-		if err := err.OriginalError; err != nil {
+		if err.Extensions != nil {
+			ret.Extensions = err.Extensions
+		} else if err := err.OriginalError; err != nil {
 			if extended, ok := err.(ExtendedError); ok {
 				ret.Extensions = extended.Extensions()
 			}
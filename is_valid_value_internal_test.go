@@ -0,0 +1,104 @@
+package graphql
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+func TestIsValidLiteralValue_NullForNonNullNamedType(t *testing.T) {
+	expected := []string{`Expected "Int!", found null.`}
+	_, result := isValidLiteralValue(NewNonNull(Int), nil)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+func TestIsValidLiteralValue_NullForNonNullListType(t *testing.T) {
+	expected := []string{`Expected "[Int]!", found null.`}
+	_, result := isValidLiteralValue(NewNonNull(NewList(Int)), nil)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+func TestIsValidLiteralValue_EmptyListForNonNullListType(t *testing.T) {
+	valueAST := ast.NewListValue(&ast.ListValue{Values: []ast.Value{}})
+	isValid, result := isValidLiteralValue(NewNonNull(NewList(Int)), valueAST)
+	if !isValid || len(result) != 0 {
+		t.Fatalf("Expected an empty list to be valid, got: %v", result)
+	}
+}
+func TestIsValidLiteralValue_ItemsForNonNullListType(t *testing.T) {
+	valueAST := ast.NewListValue(&ast.ListValue{
+		Values: []ast.Value{
+			ast.NewIntValue(&ast.IntValue{Value: "1"}),
+			ast.NewIntValue(&ast.IntValue{Value: "2"}),
+		},
+	})
+	isValid, result := isValidLiteralValue(NewNonNull(NewList(Int)), valueAST)
+	if !isValid || len(result) != 0 {
+		t.Fatalf("Expected [1, 2] to be valid, got: %v", result)
+	}
+}
+func TestIsValidInputValue_NullForNonNullListType(t *testing.T) {
+	expected := []string{`Expected "[Int!]!", found null.`}
+	_, result := isValidInputValue(nil, NewNonNull(NewList(NewNonNull(Int))))
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+func TestIsValidLiteralValue_NullElementInListOfNonNull(t *testing.T) {
+	// [1, null, 3], with the null element standing for a null literal.
+	valueAST := ast.NewListValue(&ast.ListValue{
+		Values: []ast.Value{
+			ast.NewIntValue(&ast.IntValue{Value: "1"}),
+			nil,
+			ast.NewIntValue(&ast.IntValue{Value: "3"}),
+		},
+	})
+	expected := []string{`In element #2: Expected "Int!", found null.`}
+	_, result := isValidLiteralValue(NewList(NewNonNull(Int)), valueAST)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+func TestIsValidLiteralValue_NullElementInListOfNullable(t *testing.T) {
+	valueAST := ast.NewListValue(&ast.ListValue{
+		Values: []ast.Value{
+			ast.NewIntValue(&ast.IntValue{Value: "1"}),
+			nil,
+			ast.NewIntValue(&ast.IntValue{Value: "3"}),
+		},
+	})
+	isValid, result := isValidLiteralValue(NewList(Int), valueAST)
+	if !isValid || len(result) != 0 {
+		t.Fatalf("Expected a valid value, got: %v", result)
+	}
+}
+func TestIsValidLiteralValue_NamesEachInvalidElement(t *testing.T) {
+	valueAST := ast.NewListValue(&ast.ListValue{
+		Values: []ast.Value{
+			ast.NewIntValue(&ast.IntValue{Value: "1"}),
+			ast.NewStringValue(&ast.StringValue{Value: "two"}),
+			ast.NewStringValue(&ast.StringValue{Value: "three"}),
+		},
+	})
+	expected := []string{
+		`In element #2: Expected type "Int", found "two".`,
+		`In element #3: Expected type "Int", found "three".`,
+	}
+	_, result := isValidLiteralValue(NewList(NewNonNull(Int)), valueAST)
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+func TestIsValidInputValue_NamesEachInvalidElement(t *testing.T) {
+	expected := []string{
+		`In element #2: Expected "Int!", found null.`,
+		`In element #3: Expected "Int!", found null.`,
+	}
+	_, result := isValidInputValue([]interface{}{1, nil, nil}, NewList(NewNonNull(Int)))
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
@@ -186,6 +186,44 @@ func (def *TypeExtensionDefinition) GetOperation() string {
 	return ""
 }
 
+// SchemaExtensionDefinition implements Node, Definition
+type SchemaExtensionDefinition struct {
+	Kind       string
+	Loc        *Location
+	Definition *SchemaDefinition
+}
+
+func NewSchemaExtensionDefinition(def *SchemaExtensionDefinition) *SchemaExtensionDefinition {
+	if def == nil {
+		def = &SchemaExtensionDefinition{}
+	}
+	return &SchemaExtensionDefinition{
+		Kind:       kinds.SchemaExtensionDefinition,
+		Loc:        def.Loc,
+		Definition: def.Definition,
+	}
+}
+
+func (def *SchemaExtensionDefinition) GetKind() string {
+	return def.Kind
+}
+
+func (def *SchemaExtensionDefinition) GetLoc() *Location {
+	return def.Loc
+}
+
+func (def *SchemaExtensionDefinition) GetVariableDefinitions() []*VariableDefinition {
+	return []*VariableDefinition{}
+}
+
+func (def *SchemaExtensionDefinition) GetSelectionSet() *SelectionSet {
+	return &SelectionSet{}
+}
+
+func (def *SchemaExtensionDefinition) GetOperation() string {
+	return ""
+}
+
 // DirectiveDefinition implements Node, Definition
 type DirectiveDefinition struct {
 	Kind        string
@@ -52,7 +52,8 @@ const (
 	InputObjectDefinition = "InputObjectDefinition" // previously InputObjectTypeDefinition
 
 	// Types Extensions
-	TypeExtensionDefinition = "TypeExtensionDefinition"
+	TypeExtensionDefinition   = "TypeExtensionDefinition"
+	SchemaExtensionDefinition = "SchemaExtensionDefinition"
 
 	// Directive Definitions
 	DirectiveDefinition = "DirectiveDefinition"
@@ -1366,6 +1366,8 @@ func parseInputObjectTypeDefinition(parser *Parser) (ast.Node, error) {
 
 /**
  * TypeExtensionDefinition : extend ObjectTypeDefinition
+ *
+ * SchemaExtensionDefinition : extend SchemaDefinition
  */
 func parseTypeExtensionDefinition(parser *Parser) (ast.Node, error) {
 	start := parser.Token.Start
@@ -1374,6 +1376,17 @@ func parseTypeExtensionDefinition(parser *Parser) (ast.Node, error) {
 		return nil, err
 	}
 
+	if parser.Token.Value == "schema" {
+		definition, err := parseSchemaDefinition(parser)
+		if err != nil {
+			return nil, err
+		}
+		return ast.NewSchemaExtensionDefinition(&ast.SchemaExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition.(*ast.SchemaDefinition),
+		}), nil
+	}
+
 	definition, err := parseObjectTypeDefinition(parser)
 	if err != nil {
 		return nil, err
@@ -121,6 +121,43 @@ extend type Hello {
 	}
 }
 
+func TestSchemaParser_SimpleSchemaExtension(t *testing.T) {
+
+	body := `
+extend schema {
+  mutation: Mutation
+}`
+	astDoc := parse(t, body)
+	expected := ast.NewDocument(&ast.Document{
+		Loc: testLoc(1, 39),
+		Definitions: []ast.Node{
+			ast.NewSchemaExtensionDefinition(&ast.SchemaExtensionDefinition{
+				Loc: testLoc(1, 39),
+				Definition: ast.NewSchemaDefinition(&ast.SchemaDefinition{
+					Loc:        testLoc(8, 39),
+					Directives: []*ast.Directive{},
+					OperationTypes: []*ast.OperationTypeDefinition{
+						ast.NewOperationTypeDefinition(&ast.OperationTypeDefinition{
+							Loc:       testLoc(19, 37),
+							Operation: "mutation",
+							Type: ast.NewNamed(&ast.Named{
+								Loc: testLoc(29, 37),
+								Name: ast.NewName(&ast.Name{
+									Value: "Mutation",
+									Loc:   testLoc(29, 37),
+								}),
+							}),
+						}),
+					},
+				}),
+			}),
+		},
+	})
+	if !reflect.DeepEqual(astDoc, expected) {
+		t.Fatalf("unexpected document, expected: %v, got: %v", expected, astDoc)
+	}
+}
+
 func TestSchemaParser_SimpleNonNullType(t *testing.T) {
 
 	body := `
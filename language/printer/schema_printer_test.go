@@ -23,6 +23,18 @@ func TestSchemaPrinter_PrintsMinimalAST(t *testing.T) {
 	}
 }
 
+func TestSchemaPrinter_PrintsSchemaExtension(t *testing.T) {
+	astDoc := parse(t, `extend schema @onSchema { mutation: MutationType }`)
+	results := printer.Print(astDoc)
+	expected := `extend schema @onSchema {
+  mutation: MutationType
+}
+`
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, results))
+	}
+}
+
 func TestSchemaPrinter_DoesNotAlterAST(t *testing.T) {
 	b, err := ioutil.ReadFile("../../schema-kitchen-sink.graphql")
 	if err != nil {
@@ -0,0 +1,131 @@
+package graphql
+
+import (
+	"fmt"
+)
+
+// MessageFormatter builds the messages of validation errors, e.g. to localize
+// or customize them.
+//
+// The key names the message, usually after the rule reporting it such as
+// "FieldsOnCorrectType", and args are the values the message refers to, in
+// the order of the verbs in the matching DefaultMessageTemplates entry.
+type MessageFormatter interface {
+	Format(key string, args ...interface{}) string
+}
+
+// MessageTemplates is a MessageFormatter which formats each key with its
+// fmt template. Keys without a template fall back to DefaultMessageTemplates,
+// so only the messages to customize need to be listed.
+type MessageTemplates map[string]string
+
+func (t MessageTemplates) Format(key string, args ...interface{}) string {
+	template, ok := t[key]
+	if !ok {
+		template = DefaultMessageTemplates[key]
+	}
+	return fmt.Sprintf(template, args...)
+}
+
+// DefaultMessageTemplates The English messages reported by the validation rules.
+var DefaultMessageTemplates = MessageTemplates{
+	"ArgumentOrder":                                    `Argument "%v" of field "%v.%v" should be given before argument "%v".`,
+	"ArgumentsOfCorrectType":                           `Argument "%v" has invalid value %v.%v`,
+	"BannedFields":                                     `Field "%v.%v" is not allowed.`,
+	"DefaultValuesOfCorrectType":                       `Variable "$%v" has invalid default value: %v.%v`,
+	"DefaultValuesOfCorrectType.RequiredDefault":       `Variable "$%v" of type "%v" is required and will not use the default value. Perhaps you meant to use type "%v".`,
+	"DeferDirectiveOnRootField":                        `@defer is not allowed on root fields of %v.`,
+	"DidYouMean":                                       `%v Did you mean %v?`,
+	"DidYouMean.InlineFragment":                        `%v Did you mean to use an inline fragment on %v?`,
+	"DidYouMean.TypeCondition":                         `%v Did you mean to add a type condition on %v to the inline fragment?`,
+	"FieldsOnCorrectType":                              `Cannot query field "%v" on type "%v".`,
+	"FragmentVariableShadowing":                        `Variable "$%v" used in fragment "%v" is defined as "%v" by operation %v but as "%v" by operation %v.`,
+	"FragmentsOnCompositeTypes":                        `Fragment "%v" cannot condition on non composite type "%v".`,
+	"FragmentsOnCompositeTypes.EnumType":               `Fragment "%v" cannot condition on enum type "%v".`,
+	"FragmentsOnCompositeTypes.InputType":              `Fragment "%v" cannot condition on input type "%v".`,
+	"FragmentsOnCompositeTypes.ScalarType":             `Fragment "%v" cannot condition on scalar type "%v".`,
+	"FragmentsOnCompositeTypes.Inline":                 `Fragment cannot condition on non composite type "%v".`,
+	"FragmentsOnCompositeTypes.Inline.EnumType":        `Fragment cannot condition on enum type "%v".`,
+	"FragmentsOnCompositeTypes.Inline.InputType":       `Fragment cannot condition on input type "%v".`,
+	"FragmentsOnCompositeTypes.Inline.ScalarType":      `Fragment cannot condition on scalar type "%v".`,
+	"KnownArgumentNames":                               `Unknown argument "%v" on field "%v" of type "%v".`,
+	"KnownArgumentNames.Directive":                     `Unknown argument "%v" on directive "@%v".`,
+	"KnownDirectives":                                  `Unknown directive "%v".`,
+	"KnownDirectives.Misplaced":                        `Directive "%v" may not be used on %v.`,
+	"KnownFragmentNames":                               `Unknown fragment "%v".`,
+	"KnownTypeNames":                                   `Unknown type "%v".`,
+	"LoneAnonymousOperation":                           `This anonymous operation must be the only defined operation.`,
+	"MaxFragments":                                     `Document defines %v fragments, more than the maximum of %v.`,
+	"MaxInputDepth":                                    `Input object is nested deeper than the maximum depth of %v.`,
+	"MaxRootFields":                                    `Operation "%v" selects %v root fields, more than the maximum of %v.`,
+	"MaxRootFields.Anonymous":                          `Anonymous operation selects %v root fields, more than the maximum of %v.`,
+	"NoFragmentCycles":                                 `Cannot spread fragment "%v" within itself.`,
+	"NoFragmentCycles.Via":                             `Cannot spread fragment "%v" within itself via %v.`,
+	"NoMixedRootOperations":                            `Operation "%v" is a %v, but the document already contains a %v. Keep queries and mutations in separate documents.`,
+	"NoMixedRootOperations.Anonymous":                  `Anonymous operation is a %v, but the document already contains a %v. Keep queries and mutations in separate documents.`,
+	"NoTypenameAlias":                                  `Field "%v" cannot be aliased to "__typename".`,
+	"NoUndefinedVariables":                             `Variable "$%v" is not defined.`,
+	"NoUndefinedVariables.Operation":                   `Variable "$%v" is not defined by operation "%v".`,
+	"NoUnusedFragments":                                `Fragment "%v" is never used.`,
+	"NoUnusedVariables":                                `Variable "$%v" is never used.`,
+	"NoUnusedVariables.Operation":                      `Variable "$%v" is never used in operation "%v".`,
+	"NoVariablesInConstants":                           `Variable "$%v" is not allowed in this context.`,
+	"NonEmptyOperation":                                `Operation "%v" must select at least one field.`,
+	"NonEmptyOperation.Anonymous":                      `Anonymous operation must select at least one field.`,
+	"NonEmptySelectionSet":                             `Field "%v" must select at least one subfield.`,
+	"ObjectImplementsInterface.ArgumentType":           `Interface field argument "%v.%v(%v:)" expects type "%v" but "%v.%v(%v:)" is type "%v".`,
+	"ObjectImplementsInterface.FieldType":              `Interface field "%v.%v" expects type "%v" but "%v.%v" is type "%v".`,
+	"ObjectImplementsInterface.MissingArgument":        `Interface field argument "%v.%v(%v:)" expected but "%v.%v" does not provide it.`,
+	"ObjectImplementsInterface.MissingField":           `Interface field "%v.%v" expected but "%v" does not provide it.`,
+	"ObjectImplementsInterface.RequiredArgument":       `Object field "%v.%v" includes required argument "%v" that is missing from the Interface field "%v.%v".`,
+	"OperationTypeExists.Mutation":                     `Schema is not configured for mutations.`,
+	"OperationTypeExists.Query":                        `Schema is not configured for queries.`,
+	"OperationTypeExists.Subscription":                 `Schema is not configured for subscriptions.`,
+	"OperationTypeExists.Unknown":                      `Can only validate queries, mutations and subscriptions.`,
+	"OverlappingFieldsCanBeMerged":                     `Fields "%v" conflict because %v. Use different aliases on the fields to fetch both if this was intentional.`,
+	"PersistedOperations":                              `Operation not allowed.`,
+	"PossibleFragmentSpreads":                          `Fragment "%v" cannot be spread here as objects of type "%v" can never be of type "%v".`,
+	"PossibleFragmentSpreads.Inline":                   `Fragment cannot be spread here as objects of type "%v" can never be of type "%v".`,
+	"PossibleTypeExtensions.NonObject":                 `Cannot extend non-object type "%v".`,
+	"PossibleTypeExtensions.NotDefined":                `Cannot extend type "%v" because it is not defined.`,
+	"PreferReplacementField":                           `Field "%v.%v" is deprecated, select "%v" instead.`,
+	"ProvidedNonNullArguments":                         `Field "%v" argument "%v" of type "%v" is required but not provided.`,
+	"ProvidedNonNullArguments.Directive":               `Directive "@%v" argument "%v" of type "%v" is required but not provided.`,
+	"RedundantInlineFragment":                          `Inline fragment on "%v" is redundant as the selection is already of type "%v". Consider removing the type condition.`,
+	"RequireDescriptions.Argument":                     `Argument "%v.%v(%v:)" has no description.`,
+	"RequireDescriptions.DirectiveArgument":            `Argument "@%v(%v:)" has no description.`,
+	"RequireDescriptions.Field":                        `Field "%v.%v" has no description.`,
+	"RequireDescriptions.Type":                         `Type "%v" has no description.`,
+	"RequireDirectiveOnTypes":                          `Type "%v" must have the "@%v" directive.`,
+	"RequirePaginationArgs":                            `Field "%v" must be paginated with one of the "first", "after", "last" or "before" arguments.`,
+	"RequireTypenameOnAbstract":                        `Selection set on abstract type "%v" should include "__typename".`,
+	"ScalarLeafs.NoSubselectionAllowed":                `Field "%v" of type "%v" must not have a sub selection.`,
+	"ScalarLeafs.RequiredSubselection":                 `Field "%v" of type "%v" must have a sub selection.`,
+	"SingleFieldSubscriptions":                         `Subscription "%v" must select only one top level field.`,
+	"SingleFieldSubscriptions.Anonymous":               `Anonymous Subscription must select only one top level field.`,
+	"SingleFieldSubscriptions.Introspection":           `Subscription "%v" must not select an introspection top level field.`,
+	"SingleFieldSubscriptions.Introspection.Anonymous": `Anonymous Subscription must not select an introspection top level field.`,
+	"SkipIncludeConflict":                              `Directives "@skip(if: %v)" and "@include(if: %v)" contradict each other, so the selection is never included.`,
+	"StreamDirectiveOnListField":                       `Directive "@stream" cannot be used on non-list field "%v.%v".`,
+	"StreamInitialCount":                               `@stream initialCount must not be negative.`,
+	"SubscriptionRootFieldUnconditional":               `Subscription "%v" must not use "@%v" on its root field.`,
+	"SubscriptionRootFieldUnconditional.Anonymous":     `Anonymous Subscription must not use "@%v" on its root field.`,
+	"UniqueArgumentNames":                              `There can be only one argument named "%v".`,
+	"UniqueDirectiveNames":                             `There can be only one directive named "@%v".`,
+	"UniqueDirectiveNames.Existing":                    `Directive "@%v" already exists in the schema. It cannot be redefined.`,
+	"UniqueDirectivesPerLocation":                      `The directive "@%v" can only be used once at this location.`,
+	"UniqueFieldDefinitionNames":                       `Field "%v.%v" can only be defined once.`,
+	"UniqueFieldDefinitionNames.Existing":              `Field "%v.%v" already exists in the schema. It cannot also be defined in this type extension.`,
+	"UniqueFragmentNames":                              `There can only be one fragment named "%v".`,
+	"UniqueInputFieldNames":                            `There can be only one input field named "%v".`,
+	"UniqueOperationNames":                             `There can only be one operation named "%v".`,
+	"UniqueTypeNames":                                  `There can be only one type named "%v".`,
+	"UniqueTypeNames.Existing":                         `Type "%v" already exists in the schema. It cannot also be defined in this type definition.`,
+	"UniqueVariableNames":                              `There can only be one variable named "%v".`,
+	"UnknownPersistedQuery":                            `Unknown persisted query "%v".`,
+	"ValidSchemaDefinition.Duplicate":                  `There can be only one %v type in schema.`,
+	"ValidSchemaDefinition.Existing":                   `Type for %v already defined in the schema. It cannot be redefined.`,
+	"ValidSchemaDefinition.NonObject":                  `%v root type must be Object type, it cannot be %v.`,
+	"VariablesAreInputTypes":                           `Variable "$%v" cannot be non-input type "%v".`,
+	"VariablesInAllowedPosition":                       `Variable "$%v" of type "%v" used in position expecting type "%v".`,
+}
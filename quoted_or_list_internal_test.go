@@ -33,3 +33,10 @@ func TestQuotedOrList_ReturnsCommaSeparatedManyItemList(t *testing.T) {
 		t.Fatalf("Expected %v, got: %v", expected, result)
 	}
 }
+func TestQuotedOrList_ReturnsThreeItemsWithOxfordComma(t *testing.T) {
+	expected := `"A", "B", or "C"`
+	result := QuotedOrList([]string{"A", "B", "C"})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
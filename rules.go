@@ -1,11 +1,15 @@
 package graphql
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
@@ -18,6 +22,7 @@ import (
 var SpecifiedRules = []ValidationRuleFn{
 	ArgumentsOfCorrectTypeRule,
 	DefaultValuesOfCorrectTypeRule,
+	DeferDirectiveOnRootFieldRule,
 	FieldsOnCorrectTypeRule,
 	FragmentsOnCompositeTypesRule,
 	KnownArgumentNamesRule,
@@ -29,11 +34,16 @@ var SpecifiedRules = []ValidationRuleFn{
 	NoUndefinedVariablesRule,
 	NoUnusedFragmentsRule,
 	NoUnusedVariablesRule,
+	NoVariablesInConstantsRule,
 	OverlappingFieldsCanBeMergedRule,
 	PossibleFragmentSpreadsRule,
 	ProvidedNonNullArgumentsRule,
 	ScalarLeafsRule,
+	SingleFieldSubscriptionsRule,
+	StreamDirectiveOnListFieldRule,
+	StreamInitialCountRule,
 	UniqueArgumentNamesRule,
+	UniqueDirectivesPerLocationRule,
 	UniqueFragmentNamesRule,
 	UniqueInputFieldNamesRule,
 	UniqueOperationNamesRule,
@@ -42,6 +52,18 @@ var SpecifiedRules = []ValidationRuleFn{
 	VariablesInAllowedPositionRule,
 }
 
+// SpecifiedSDLRules set includes the validation rules for type system (SDL)
+// documents, run by ValidateSDL.
+var SpecifiedSDLRules = []ValidationRuleFn{
+	NoVariablesInConstantsRule,
+	ObjectImplementsInterfaceRule,
+	PossibleTypeExtensionsRule,
+	UniqueDirectiveNamesRule,
+	UniqueFieldDefinitionNamesRule,
+	UniqueTypeNamesRule,
+	ValidSchemaDefinitionRule,
+}
+
 type ValidationRuleInstance struct {
 	VisitorOpts *visitor.VisitorOptions
 }
@@ -63,6 +85,69 @@ func reportError(context *ValidationContext, message string, nodes []ast.Node) (
 	return visitor.ActionNoChange, nil
 }
 
+func reportWarning(context *ValidationContext, message string, nodes []ast.Node) (string, interface{}) {
+	context.ReportWarning(newValidationError(message, nodes))
+	return visitor.ActionNoChange, nil
+}
+
+// NewArgumentOrderRule Argument order
+//
+// A lint rule which warns about fields whose arguments aren't given in the
+// expected order, so that documents are written consistently. The order maps
+// field coordinates, e.g. "Query.users", to the names of their arguments in
+// the expected order, since the arguments of a field are configured in a map
+// and FieldDefinition.Args has no meaningful order. Fields without an order,
+// and arguments missing from it, are ignored.
+func NewArgumentOrderRule(order map[string][]string) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.Field)
+						fieldDef := context.FieldDef()
+						parentType := context.ParentType()
+						if !ok || node == nil || fieldDef == nil || parentType == nil || reflect.ValueOf(parentType).IsNil() {
+							return visitor.ActionNoChange, nil
+						}
+						argNames, ok := order[fmt.Sprintf("%v.%v", parentType.Name(), fieldDef.Name)]
+						if !ok {
+							return visitor.ActionNoChange, nil
+						}
+						argIndexes := map[string]int{}
+						for index, argName := range argNames {
+							argIndexes[argName] = index
+						}
+						var previousArg *ast.Argument
+						for _, argAST := range node.Arguments {
+							if argAST == nil || argAST.Name == nil {
+								continue
+							}
+							index, ok := argIndexes[argAST.Name.Value]
+							if !ok {
+								continue
+							}
+							if previousArg != nil && index < argIndexes[previousArg.Name.Value] {
+								return reportWarning(
+									context,
+									context.FormatMessage("ArgumentOrder",
+										argAST.Name.Value, parentType.Name(), fieldDef.Name, previousArg.Name.Value),
+									[]ast.Node{argAST},
+								)
+							}
+							previousArg = argAST
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
 // ArgumentsOfCorrectTypeRule Argument values of correct type
 //
 // A GraphQL document is only valid if all field argument literal values are
@@ -74,7 +159,7 @@ func ArgumentsOfCorrectTypeRule(context *ValidationContext) *ValidationRuleInsta
 				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
 					if argAST, ok := p.Node.(*ast.Argument); ok {
 						if argDef := context.Argument(); argDef != nil {
-							if isValid, messages := isValidLiteralValue(argDef.Type, argAST.Value); !isValid {
+							if isValid, messages := isValidLiteralValueWithPrinter(argDef.Type, argAST.Value, context.valuePrinter()); !isValid {
 								var messagesStr, argNameValue string
 								if argAST.Name != nil {
 									argNameValue = argAST.Name.Value
@@ -83,12 +168,18 @@ func ArgumentsOfCorrectTypeRule(context *ValidationContext) *ValidationRuleInsta
 								if len(messages) > 0 {
 									messagesStr = "\n" + strings.Join(messages, "\n")
 								}
-								reportError(
-									context,
-									fmt.Sprintf(`Argument "%v" has invalid value %v.%v`,
-										argNameValue, printer.Print(argAST.Value), messagesStr),
+								printedValue := context.valuePrinter()(argAST.Value)
+								err := newValidationError(
+									context.FormatMessage("ArgumentsOfCorrectType",
+										argNameValue, printedValue, messagesStr),
 									[]ast.Node{argAST.Value},
 								)
+								err.Extensions = map[string]interface{}{
+									"argumentName": argNameValue,
+									"expectedType": fmt.Sprintf("%v", argDef.Type),
+									"value":        printedValue,
+								}
+								context.ReportError(err)
 							}
 
 						}
@@ -103,6 +194,50 @@ func ArgumentsOfCorrectTypeRule(context *ValidationContext) *ValidationRuleInsta
 	}
 }
 
+// NewBannedFieldsRule Banned fields
+//
+// A GraphQL document is only valid if it doesn't select any of the banned
+// fields, e.g. sensitive fields such as "password", given as the names of the
+// banned fields by the name of their type.
+func NewBannedFieldsRule(banned map[string][]string) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		isBanned := map[string]map[string]bool{}
+		for typeName, fieldNames := range banned {
+			isBanned[typeName] = map[string]bool{}
+			for _, fieldName := range fieldNames {
+				isBanned[typeName][fieldName] = true
+			}
+		}
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.Field)
+						if !ok || node == nil || node.Name == nil {
+							return visitor.ActionNoChange, nil
+						}
+						parentType := context.ParentType()
+						if parentType == nil || reflect.ValueOf(parentType).IsNil() {
+							return visitor.ActionNoChange, nil
+						}
+						if isBanned[parentType.Name()][node.Name.Value] {
+							reportError(
+								context,
+								context.FormatMessage("BannedFields", parentType.Name(), node.Name.Value),
+								[]ast.Node{node},
+							)
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
 // DefaultValuesOfCorrectTypeRule Variable default values of correct type
 //
 // A GraphQL document is only valid if all variable default values are of the
@@ -123,23 +258,28 @@ func DefaultValuesOfCorrectTypeRule(context *ValidationContext) *ValidationRuleI
 						}
 						ttype := context.InputType()
 
-						// when input variable value must be nonNull, and set default value is unnecessary
+						// when input variable value must be nonNull, and set default value is unnecessary.
+						// The parser doesn't accept null literals yet, so `= null` defaults,
+						// for nullable or non-null types, are syntax errors and never get here.
 						if ttype, ok := ttype.(*NonNull); ok && defaultValue != nil {
 							reportError(
 								context,
-								fmt.Sprintf(`Variable "$%v" of type "%v" is required and will not use the default value. Perhaps you meant to use type "%v".`,
+								context.FormatMessage("DefaultValuesOfCorrectType.RequiredDefault",
 									name, ttype, ttype.OfType),
 								[]ast.Node{defaultValue},
 							)
+							// The default value is never used, so whether it is
+							// valid doesn't matter: report the variable once.
+							return visitor.ActionSkip, nil
 						}
-						if isValid, messages := isValidLiteralValue(ttype, defaultValue); !isValid && defaultValue != nil {
+						if isValid, messages := isValidLiteralValueWithPrinter(ttype, defaultValue, context.valuePrinter()); !isValid && defaultValue != nil {
 							if len(messages) > 0 {
 								messagesStr = "\n" + strings.Join(messages, "\n")
 							}
 							reportError(
 								context,
-								fmt.Sprintf(`Variable "$%v" has invalid default value: %v.%v`,
-									name, printer.Print(defaultValue), messagesStr),
+								context.FormatMessage("DefaultValuesOfCorrectType",
+									name, context.printValue(defaultValue), messagesStr),
 								[]ast.Node{defaultValue},
 							)
 						}
@@ -171,8 +311,96 @@ func quoteStrings(slice []string) []string {
 	return quoted
 }
 
-// quotedOrList Given [ A, B, C ] return '"A", "B", or "C"'.
-// Notice oxford comma
+// DeferDirectiveOnRootFieldRule Defer directive on root field
+//
+// A GraphQL document is only valid if @defer isn't applied to fragments
+// selecting the root fields of a mutation or a subscription, whose root
+// fields can't be delivered incrementally. Fragments are expanded, so @defer
+// is reported wherever it applies to the root selection set of such an
+// operation, and not on fields of the mutation type selected further down.
+// It only applies to schemas using the built-in DeferDirective, see
+// IncrementalDeliveryDirectives, not to schemas defining their own @defer.
+func DeferDirectiveOnRootFieldRule(context *ValidationContext) *ValidationRuleInstance {
+	schema := context.Schema()
+	deferDefined := schema != nil && schema.Directive(DeferDirective.Name) == DeferDirective
+	// reported tracks the directives already reported by operation type, as
+	// fragments may be spread by several operations.
+	reported := map[string]map[*ast.Directive]bool{
+		ast.OperationTypeMutation:     {},
+		ast.OperationTypeSubscription: {},
+	}
+	checkDefer := func(operationType string, directives []*ast.Directive) {
+		for _, directive := range directives {
+			if directive == nil || directive.Name == nil || directive.Name.Value != DeferDirective.Name || reported[operationType][directive] {
+				continue
+			}
+			reported[operationType][directive] = true
+			reportError(
+				context,
+				context.FormatMessage("DeferDirectiveOnRootField", operationType),
+				[]ast.Node{directive},
+			)
+		}
+	}
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.OperationDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					operation, ok := p.Node.(*ast.OperationDefinition)
+					if !ok || operation == nil || !deferDefined {
+						return visitor.ActionSkip, nil
+					}
+					operationType := operation.Operation
+					if operationType != ast.OperationTypeMutation && operationType != ast.OperationTypeSubscription {
+						return visitor.ActionSkip, nil
+					}
+					// Walk the root selection set, through the fragments it
+					// spreads, down to the root fields.
+					visitedFragments := map[string]bool{}
+					var checkRootSelections func(selectionSet *ast.SelectionSet)
+					checkRootSelections = func(selectionSet *ast.SelectionSet) {
+						if selectionSet == nil {
+							return
+						}
+						for _, selection := range selectionSet.Selections {
+							switch selection := selection.(type) {
+							case *ast.InlineFragment:
+								checkDefer(operationType, selection.Directives)
+								checkRootSelections(selection.SelectionSet)
+							case *ast.FragmentSpread:
+								checkDefer(operationType, selection.Directives)
+								if selection.Name == nil || visitedFragments[selection.Name.Value] {
+									continue
+								}
+								visitedFragments[selection.Name.Value] = true
+								if fragment := context.Fragment(selection.Name.Value); fragment != nil {
+									checkRootSelections(fragment.SelectionSet)
+								}
+							}
+						}
+					}
+					checkRootSelections(operation.SelectionSet)
+					return visitor.ActionSkip, nil
+				},
+			},
+			kinds.FragmentDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// QuotedOrList Given [ A, B, C ] return '"A", "B", or "C"'.
+// Notice oxford comma. At most five items are listed.
+func QuotedOrList(slice []string) string {
+	return quotedOrList(slice)
+}
+
 func quotedOrList(slice []string) string {
 	maxLength := 5
 	if len(slice) == 0 {
@@ -191,11 +419,15 @@ func quotedOrList(slice []string) string {
 	return quoted[0]
 }
 func UndefinedFieldMessage(fieldName string, ttypeName string, suggestedTypeNames []string, suggestedFieldNames []string) string {
-	message := fmt.Sprintf(`Cannot query field "%v" on type "%v".`, fieldName, ttypeName)
+	return undefinedFieldMessage(DefaultMessageTemplates, fieldName, ttypeName, suggestedTypeNames, suggestedFieldNames)
+}
+
+func undefinedFieldMessage(formatter MessageFormatter, fieldName string, ttypeName string, suggestedTypeNames []string, suggestedFieldNames []string) string {
+	message := formatter.Format("FieldsOnCorrectType", fieldName, ttypeName)
 	if len(suggestedTypeNames) > 0 {
-		message = fmt.Sprintf(`%v Did you mean to use an inline fragment on %v?`, message, quotedOrList(suggestedTypeNames))
+		message = formatter.Format("DidYouMean.InlineFragment", message, quotedOrList(suggestedTypeNames))
 	} else if len(suggestedFieldNames) > 0 {
-		message = fmt.Sprintf(`%v Did you mean %v?`, message, quotedOrList(suggestedFieldNames))
+		message = formatter.Format("DidYouMean", message, quotedOrList(suggestedFieldNames))
 	}
 	return message
 }
@@ -205,11 +437,31 @@ func UndefinedFieldMessage(fieldName string, ttypeName string, suggestedTypeName
 // A GraphQL document is only valid if all fields selected are defined by the
 // parent type, or are an allowed meta field such as __typenamme
 func FieldsOnCorrectTypeRule(context *ValidationContext) *ValidationRuleInstance {
+	// Whether the selection set being visited is the one of an inline
+	// fragment without a type condition, for each enclosing field and inline
+	// fragment, to hint at adding the missing type condition.
+	inConditionlessFragment := []bool{}
+	directlyInConditionlessFragment := func() bool {
+		return len(inConditionlessFragment) > 0 && inConditionlessFragment[len(inConditionlessFragment)-1]
+	}
 	visitorOpts := &visitor.VisitorOptions{
 		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.InlineFragment: {
+				Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.InlineFragment)
+					inConditionlessFragment = append(inConditionlessFragment, ok && node != nil && node.TypeCondition == nil)
+					return visitor.ActionNoChange, nil
+				},
+				Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+					inConditionlessFragment = inConditionlessFragment[:len(inConditionlessFragment)-1]
+					return visitor.ActionNoChange, nil
+				},
+			},
 			kinds.Field: {
-				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+				Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
 					var action = visitor.ActionNoChange
+					directlyInConditionless := directlyInConditionlessFragment()
+					inConditionlessFragment = append(inConditionlessFragment, false)
 					if node, ok := p.Node.(*ast.Field); ok {
 						var ttype Composite
 						if ttype = context.ParentType(); ttype == nil {
@@ -223,28 +475,45 @@ func FieldsOnCorrectTypeRule(context *ValidationContext) *ValidationRuleInstance
 						}
 						fieldDef := context.FieldDef()
 						if fieldDef == nil {
-							// This field doesn't exist, lets look for suggestions.
 							var nodeName string
 							if node.Name != nil {
 								nodeName = node.Name.Value
 							}
+							if context.isAllowedMetaField(ttype, nodeName) {
+								return action, nil
+							}
+							// This field doesn't exist, lets look for suggestions.
 							// First determine if there are any suggested types to condition on.
 							suggestedTypeNames := getSuggestedTypeNames(context.Schema(), ttype, nodeName)
 
 							// If there are no suggested types, then perhaps this was a typo?
 							suggestedFieldNames := []string{}
 							if len(suggestedTypeNames) == 0 {
-								suggestedFieldNames = getSuggestedFieldNames(context.Schema(), ttype, nodeName)
+								suggestedFieldNames = getSuggestedFieldNames(context, ttype, nodeName)
+							}
+							message := undefinedFieldMessage(context.messageFormatter(), nodeName, ttype.Name(), suggestedTypeNames, suggestedFieldNames)
+							if directlyInConditionless && len(suggestedTypeNames) > 0 {
+								// The field is already in an inline fragment, which only
+								// lacks the type condition.
+								message = context.FormatMessage(
+									"DidYouMean.TypeCondition",
+									context.FormatMessage("FieldsOnCorrectType", nodeName, ttype.Name()),
+									quotedOrList(suggestedTypeNames),
+								)
 							}
 							reportError(
 								context,
-								UndefinedFieldMessage(nodeName, ttype.Name(), suggestedTypeNames, suggestedFieldNames),
+								message,
 								[]ast.Node{node},
 							)
 						}
 					}
 					return action, nil
 				},
+				Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+					inConditionlessFragment = inConditionlessFragment[:len(inConditionlessFragment)-1]
+					return visitor.ActionNoChange, nil
+				},
 			},
 		},
 	}
@@ -323,7 +592,7 @@ func getSuggestedTypeNames(schema *Schema, ttype Output, fieldName string) []str
 
 // getSuggestedFieldNames For the field name provided, determine if there are any similar field names
 // that may be the result of a typo.
-func getSuggestedFieldNames(schema *Schema, ttype Output, fieldName string) []string {
+func getSuggestedFieldNames(context *ValidationContext, ttype Output, fieldName string) []string {
 
 	fields := FieldDefinitionMap{}
 	switch ttype := ttype.(type) {
@@ -339,7 +608,7 @@ func getSuggestedFieldNames(schema *Schema, ttype Output, fieldName string) []st
 	for possibleFieldName := range fields {
 		possibleFieldNames = append(possibleFieldNames, possibleFieldName)
 	}
-	return suggestionList(fieldName, possibleFieldNames)
+	return context.SuggestionList(fieldName, possibleFieldNames)
 }
 
 // suggestedInterface an internal struct to sort interface by usage count
@@ -362,6 +631,131 @@ func (s suggestedInterfaceSortedSlice) Less(i, j int) bool {
 	return s[i].count > s[j].count
 }
 
+// NewFragmentVariableShadowingRule Fragment variable shadowing
+//
+// A GraphQL document is only valid if the operations which share a fragment
+// define the variables that fragment uses with types accepted where it uses
+// them, e.g. one operation may define "$x: Int!" and another "$x: Int" for a
+// nullable position, but not "$x: String". Variables which no operation
+// defines with an accepted type are left to VariablesInAllowedPositionRule.
+func NewFragmentVariableShadowingRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		operationDefs := []*ast.OperationDefinition{}
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if node, ok := p.Node.(*ast.OperationDefinition); ok && node != nil {
+							operationDefs = append(operationDefs, node)
+						}
+						return visitor.ActionSkip, nil
+					},
+				},
+				kinds.FragmentDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						return visitor.ActionSkip, nil
+					},
+				},
+				kinds.Document: {
+					Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if context.Schema() == nil {
+							return visitor.ActionNoChange, nil
+						}
+						type variableDefinedBy struct {
+							operationName string
+							typeName      string
+						}
+						type incompatibleUsage struct {
+							fragment  *ast.FragmentDefinition
+							usage     *VariableUsage
+							definedBy variableDefinedBy
+						}
+						// The first operation defining each variable of each
+						// fragment with a type the fragment accepts, and the
+						// usages the other operations define with a type it
+						// doesn't accept.
+						firstDefinitions := map[*ast.FragmentDefinition]map[string]variableDefinedBy{}
+						incompatibleUsages := []incompatibleUsage{}
+						for _, operation := range operationDefs {
+							operationName := operationDisplayName(operation)
+							varDefs := map[string]*ast.VariableDefinition{}
+							for _, varDef := range operation.VariableDefinitions {
+								if varDef != nil && varDef.Variable != nil && varDef.Variable.Name != nil {
+									varDefs[varDef.Variable.Name.Value] = varDef
+								}
+							}
+							for _, fragment := range context.RecursivelyReferencedFragments(operation) {
+								if _, ok := firstDefinitions[fragment]; !ok {
+									firstDefinitions[fragment] = map[string]variableDefinedBy{}
+								}
+								for _, usage := range context.VariableUsages(fragment) {
+									if usage == nil || usage.Node == nil || usage.Node.Name == nil || usage.Type == nil {
+										continue
+									}
+									varName := usage.Node.Name.Value
+									varDef, ok := varDefs[varName]
+									if !ok {
+										continue
+									}
+									varType, _ := context.typeFromAST(varDef.Type)
+									if varType == nil {
+										continue
+									}
+									definedBy := variableDefinedBy{operationName, fmt.Sprintf("%v", printer.Print(varDef.Type))}
+									if !isTypeSubTypeOf(context.Schema(), effectiveType(varType, varDef), usage.Type) {
+										incompatibleUsages = append(incompatibleUsages, incompatibleUsage{fragment, usage, definedBy})
+										continue
+									}
+									if _, ok := firstDefinitions[fragment][varName]; !ok {
+										firstDefinitions[fragment][varName] = definedBy
+									}
+								}
+							}
+						}
+						// A variable which no operation defines with an accepted
+						// type is reported by VariablesInAllowedPositionRule.
+						reported := map[string]bool{}
+						for _, incompatible := range incompatibleUsages {
+							varName := incompatible.usage.Node.Name.Value
+							first, ok := firstDefinitions[incompatible.fragment][varName]
+							if !ok {
+								continue
+							}
+							fragName := ""
+							if incompatible.fragment.Name != nil {
+								fragName = incompatible.fragment.Name.Value
+							}
+							key := fmt.Sprintf("%v.%v.%v", fragName, varName, incompatible.definedBy.operationName)
+							if reported[key] {
+								continue
+							}
+							reported[key] = true
+							reportError(
+								context,
+								context.FormatMessage("FragmentVariableShadowing",
+									varName, fragName, first.typeName, first.operationName,
+									incompatible.definedBy.typeName, incompatible.definedBy.operationName),
+								[]ast.Node{incompatible.usage.Node},
+							)
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+func operationDisplayName(operation *ast.OperationDefinition) string {
+	if operation.Name != nil && operation.Name.Value != "" {
+		return fmt.Sprintf(`"%v"`, operation.Name.Value)
+	}
+	return "<anonymous>"
+}
+
 // FragmentsOnCompositeTypesRule Fragments on composite type
 //
 // Fragments use a type condition to determine if they apply, since fragments
@@ -377,7 +771,7 @@ func FragmentsOnCompositeTypesRule(context *ValidationContext) *ValidationRuleIn
 						if node.TypeCondition != nil && ttype != nil && !IsCompositeType(ttype) {
 							reportError(
 								context,
-								fmt.Sprintf(`Fragment cannot condition on non composite type "%v".`, ttype),
+								context.FormatMessage(inlineFragmentOnNonCompositeKey(ttype), ttype),
 								[]ast.Node{node.TypeCondition},
 							)
 						}
@@ -396,7 +790,7 @@ func FragmentsOnCompositeTypesRule(context *ValidationContext) *ValidationRuleIn
 							}
 							reportError(
 								context,
-								fmt.Sprintf(`Fragment "%v" cannot condition on non composite type "%v".`, nodeName, printer.Print(node.TypeCondition)),
+								context.FormatMessage(fragmentOnNonCompositeKey(ttype), nodeName, printer.Print(node.TypeCondition)),
 								[]ast.Node{node.TypeCondition},
 							)
 						}
@@ -411,21 +805,50 @@ func FragmentsOnCompositeTypesRule(context *ValidationContext) *ValidationRuleIn
 	}
 }
 
-func unknownArgMessage(argName string, fieldName string, parentTypeName string, suggestedArgs []string) string {
-	message := fmt.Sprintf(`Unknown argument "%v" on field "%v" of type "%v".`, argName, fieldName, parentTypeName)
+// fragmentOnNonCompositeKey Returns the message key for a fragment definition
+// conditioned on the given non composite type, telling input, scalar and enum
+// types apart.
+func fragmentOnNonCompositeKey(ttype Type) string {
+	switch ttype.(type) {
+	case *InputObject:
+		return "FragmentsOnCompositeTypes.InputType"
+	case *Scalar:
+		return "FragmentsOnCompositeTypes.ScalarType"
+	case *Enum:
+		return "FragmentsOnCompositeTypes.EnumType"
+	}
+	return "FragmentsOnCompositeTypes"
+}
+
+// inlineFragmentOnNonCompositeKey Like fragmentOnNonCompositeKey, for inline
+// fragments.
+func inlineFragmentOnNonCompositeKey(ttype Type) string {
+	switch ttype.(type) {
+	case *InputObject:
+		return "FragmentsOnCompositeTypes.Inline.InputType"
+	case *Scalar:
+		return "FragmentsOnCompositeTypes.Inline.ScalarType"
+	case *Enum:
+		return "FragmentsOnCompositeTypes.Inline.EnumType"
+	}
+	return "FragmentsOnCompositeTypes.Inline"
+}
+
+func unknownArgMessage(formatter MessageFormatter, argName string, fieldName string, parentTypeName string, suggestedArgs []string) string {
+	message := formatter.Format("KnownArgumentNames", argName, fieldName, parentTypeName)
 
 	if len(suggestedArgs) > 0 {
-		message = fmt.Sprintf(`%v Did you mean %v?`, message, quotedOrList(suggestedArgs))
+		message = formatter.Format("DidYouMean", message, quotedOrList(suggestedArgs))
 	}
 
 	return message
 }
 
-func unknownDirectiveArgMessage(argName string, directiveName string, suggestedArgs []string) string {
-	message := fmt.Sprintf(`Unknown argument "%v" on directive "@%v".`, argName, directiveName)
+func unknownDirectiveArgMessage(formatter MessageFormatter, argName string, directiveName string, suggestedArgs []string) string {
+	message := formatter.Format("KnownArgumentNames.Directive", argName, directiveName)
 
 	if len(suggestedArgs) > 0 {
-		message = fmt.Sprintf(`%v Did you mean %v?`, message, quotedOrList(suggestedArgs))
+		message = formatter.Format("DidYouMean", message, quotedOrList(suggestedArgs))
 	}
 
 	return message
@@ -478,9 +901,10 @@ func KnownArgumentNamesRule(context *ValidationContext) *ValidationRuleInstance
 								reportError(
 									context,
 									unknownArgMessage(
+										context.messageFormatter(),
 										node.Name.Value,
 										fieldDef.Name,
-										parentTypeName, suggestionList(node.Name.Value, argNames),
+										parentTypeName, context.SuggestionList(node.Name.Value, argNames),
 									),
 									[]ast.Node{node},
 								)
@@ -500,9 +924,10 @@ func KnownArgumentNamesRule(context *ValidationContext) *ValidationRuleInstance
 								reportError(
 									context,
 									unknownDirectiveArgMessage(
+										context.messageFormatter(),
 										node.Name.Value,
 										directive.Name,
-										suggestionList(node.Name.Value, argNames),
+										context.SuggestionList(node.Name.Value, argNames),
 									),
 									[]ast.Node{node},
 								)
@@ -520,7 +945,7 @@ func KnownArgumentNamesRule(context *ValidationContext) *ValidationRuleInstance
 }
 
 func MisplaceDirectiveMessage(directiveName string, location string) string {
-	return fmt.Sprintf(`Directive "%v" may not be used on %v.`, directiveName, location)
+	return DefaultMessageTemplates.Format("KnownDirectives.Misplaced", directiveName, location)
 }
 
 // KnownDirectivesRule Known directives
@@ -550,7 +975,7 @@ func KnownDirectivesRule(context *ValidationContext) *ValidationRuleInstance {
 						if directiveDef == nil {
 							return reportError(
 								context,
-								fmt.Sprintf(`Unknown directive "%v".`, nodeName),
+								context.FormatMessage("KnownDirectives", nodeName),
 								[]ast.Node{node},
 							)
 						}
@@ -568,13 +993,13 @@ func KnownDirectivesRule(context *ValidationContext) *ValidationRuleInstance {
 						if candidateLocation == "" {
 							reportError(
 								context,
-								MisplaceDirectiveMessage(nodeName, node.GetKind()),
+								context.FormatMessage("KnownDirectives.Misplaced", nodeName, node.GetKind()),
 								[]ast.Node{node},
 							)
 						} else if !directiveHasLocation {
 							reportError(
 								context,
-								MisplaceDirectiveMessage(nodeName, candidateLocation),
+								context.FormatMessage("KnownDirectives.Misplaced", nodeName, candidateLocation),
 								[]ast.Node{node},
 							)
 						}
@@ -686,7 +1111,7 @@ func KnownFragmentNamesRule(context *ValidationContext) *ValidationRuleInstance
 						if fragment == nil {
 							reportError(
 								context,
-								fmt.Sprintf(`Unknown fragment "%v".`, fragmentName),
+								context.FormatMessage("KnownFragmentNames", fragmentName),
 								[]ast.Node{node.Name},
 							)
 						}
@@ -701,10 +1126,10 @@ func KnownFragmentNamesRule(context *ValidationContext) *ValidationRuleInstance
 	}
 }
 
-func unknownTypeMessage(typeName string, suggestedTypes []string) string {
-	message := fmt.Sprintf(`Unknown type "%v".`, typeName)
+func unknownTypeMessage(formatter MessageFormatter, typeName string, suggestedTypes []string) string {
+	message := formatter.Format("KnownTypeNames", typeName)
 	if len(suggestedTypes) > 0 {
-		message = fmt.Sprintf(`%v Did you mean %v?`, message, quotedOrList(suggestedTypes))
+		message = formatter.Format("DidYouMean", message, quotedOrList(suggestedTypes))
 	}
 
 	return message
@@ -745,7 +1170,7 @@ func KnownTypeNamesRule(context *ValidationContext) *ValidationRuleInstance {
 						if typeName != nil {
 							typeNameValue = typeName.Value
 						}
-						ttype := context.Schema().Type(typeNameValue)
+						ttype := context.resolveType(typeNameValue)
 						if ttype == nil {
 							suggestedTypes := []string{}
 							for key := range context.Schema().TypeMap() {
@@ -753,7 +1178,7 @@ func KnownTypeNamesRule(context *ValidationContext) *ValidationRuleInstance {
 							}
 							reportError(
 								context,
-								unknownTypeMessage(typeNameValue, suggestionList(typeNameValue, suggestedTypes)),
+								unknownTypeMessage(context.messageFormatter(), typeNameValue, context.SuggestionList(typeNameValue, suggestedTypes)),
 								[]ast.Node{node},
 							)
 						}
@@ -795,7 +1220,7 @@ func LoneAnonymousOperationRule(context *ValidationContext) *ValidationRuleInsta
 						if node.Name == nil && operationCount > 1 {
 							reportError(
 								context,
-								`This anonymous operation must be the only defined operation.`,
+								context.FormatMessage("LoneAnonymousOperation"),
 								[]ast.Node{node},
 							)
 						}
@@ -811,11 +1236,14 @@ func LoneAnonymousOperationRule(context *ValidationContext) *ValidationRuleInsta
 }
 
 func CycleErrorMessage(fragName string, spreadNames []string) string {
-	via := ""
+	return cycleErrorMessage(DefaultMessageTemplates, fragName, spreadNames)
+}
+
+func cycleErrorMessage(formatter MessageFormatter, fragName string, spreadNames []string) string {
 	if len(spreadNames) > 0 {
-		via = " via " + strings.Join(spreadNames, ", ")
+		return formatter.Format("NoFragmentCycles.Via", fragName, strings.Join(spreadNames, ", "))
 	}
-	return fmt.Sprintf(`Cannot spread fragment "%v" within itself%v.`, fragName, via)
+	return formatter.Format("NoFragmentCycles", fragName)
 }
 
 // NoFragmentCyclesRule No fragment cycles
@@ -886,7 +1314,7 @@ func NoFragmentCyclesRule(context *ValidationContext) *ValidationRuleInstance {
 
 				reportError(
 					context,
-					CycleErrorMessage(spreadName, spreadNames),
+					cycleErrorMessage(context.messageFormatter(), spreadName, spreadNames),
 					nodes,
 				)
 			}
@@ -924,11 +1352,57 @@ func NoFragmentCyclesRule(context *ValidationContext) *ValidationRuleInstance {
 	}
 }
 
+// NewNoMixedRootOperationsRule No mixed root operations
+//
+// A policy rule for teams keeping queries and mutations in separate files: a
+// GraphQL document is only valid if its operations are either all queries or
+// all mutations. The first operation of the other type is reported.
+func NewNoMixedRootOperationsRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		firstOperationType := ""
+		reported := false
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.OperationDefinition)
+						if !ok || node == nil || reported {
+							return visitor.ActionSkip, nil
+						}
+						operationType := node.Operation
+						if operationType != ast.OperationTypeQuery && operationType != ast.OperationTypeMutation {
+							return visitor.ActionSkip, nil
+						}
+						if firstOperationType == "" {
+							firstOperationType = operationType
+						} else if operationType != firstOperationType {
+							reported = true
+							reportError(
+								context,
+								operationMessage(context, "NoMixedRootOperations", node, operationType, firstOperationType),
+								[]ast.Node{node},
+							)
+						}
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
 func UndefinedVarMessage(varName string, opName string) string {
+	return undefinedVarMessage(DefaultMessageTemplates, varName, opName)
+}
+
+func undefinedVarMessage(formatter MessageFormatter, varName string, opName string) string {
 	if opName != "" {
-		return fmt.Sprintf(`Variable "$%v" is not defined by operation "%v".`, varName, opName)
+		return formatter.Format("NoUndefinedVariables.Operation", varName, opName)
 	}
-	return fmt.Sprintf(`Variable "$%v" is not defined.`, varName)
+	return formatter.Format("NoUndefinedVariables", varName)
 }
 
 // NoUndefinedVariablesRule No undefined variables
@@ -967,7 +1441,7 @@ func NoUndefinedVariablesRule(context *ValidationContext) *ValidationRuleInstanc
 							if res, ok := variableNameDefined[varName]; !ok || !res {
 								reportError(
 									context,
-									UndefinedVarMessage(varName, opName),
+									undefinedVarMessage(context.messageFormatter(), varName, opName),
 									[]ast.Node{usage.Node, operation},
 								)
 							}
@@ -995,6 +1469,38 @@ func NoUndefinedVariablesRule(context *ValidationContext) *ValidationRuleInstanc
 	}
 }
 
+// NewNoTypenameAliasRule No alias shadowing __typename
+//
+// An opt-in rule: a GraphQL document is only valid if no field other than
+// __typename is aliased to "__typename", e.g. `__typename: name`. The spec
+// allows such aliases, but the response would then hold a value under
+// __typename which isn't the name of the type, misleading clients.
+func NewNoTypenameAliasRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if node, ok := p.Node.(*ast.Field); ok && node != nil && node.Alias != nil && node.Name != nil {
+							if node.Alias.Value == TypeNameMetaFieldDef.Name && node.Name.Value != TypeNameMetaFieldDef.Name {
+								reportError(
+									context,
+									context.FormatMessage("NoTypenameAlias", node.Name.Value),
+									[]ast.Node{node},
+								)
+							}
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
 // NoUnusedFragmentsRule No unused fragments
 //
 // A GraphQL document is only valid if all fragment definitions are spread
@@ -1035,6 +1541,23 @@ func NoUnusedFragmentsRule(context *ValidationContext) *ValidationRuleInstance {
 							fragmentNameUsed[fragName] = true
 						}
 					}
+					// Fragments used by other documents count as used, along
+					// with the fragments they spread.
+					externalFragmentNames := append([]string{}, context.options.ExternallyUsedFragments...)
+					for len(externalFragmentNames) > 0 {
+						var fragName string
+						fragName, externalFragmentNames = externalFragmentNames[len(externalFragmentNames)-1], externalFragmentNames[:len(externalFragmentNames)-1]
+						fragment := context.Fragment(fragName)
+						if fragmentNameUsed[fragName] || fragment == nil {
+							continue
+						}
+						fragmentNameUsed[fragName] = true
+						for _, spread := range context.FragmentSpreads(fragment.SelectionSet) {
+							if spread.Name != nil {
+								externalFragmentNames = append(externalFragmentNames, spread.Name.Value)
+							}
+						}
+					}
 
 					for _, def := range fragmentDefs {
 						defName := ""
@@ -1046,7 +1569,7 @@ func NoUnusedFragmentsRule(context *ValidationContext) *ValidationRuleInstance {
 						if !ok || isFragNameUsed != true {
 							reportError(
 								context,
-								fmt.Sprintf(`Fragment "%v" is never used.`, defName),
+								context.FormatMessage("NoUnusedFragments", defName),
 								[]ast.Node{def},
 							)
 						}
@@ -1062,10 +1585,14 @@ func NoUnusedFragmentsRule(context *ValidationContext) *ValidationRuleInstance {
 }
 
 func UnusedVariableMessage(varName string, opName string) string {
+	return unusedVariableMessage(DefaultMessageTemplates, varName, opName)
+}
+
+func unusedVariableMessage(formatter MessageFormatter, varName string, opName string) string {
 	if opName != "" {
-		return fmt.Sprintf(`Variable "$%v" is never used in operation "%v".`, varName, opName)
+		return formatter.Format("NoUnusedVariables.Operation", varName, opName)
 	}
-	return fmt.Sprintf(`Variable "$%v" is never used.`, varName)
+	return formatter.Format("NoUnusedVariables", varName)
 }
 
 // NoUnusedVariablesRule No unused variables
@@ -1109,7 +1636,7 @@ func NoUnusedVariablesRule(context *ValidationContext) *ValidationRuleInstance {
 							if res, ok := variableNameUsed[variableName]; !ok || !res {
 								reportError(
 									context,
-									UnusedVariableMessage(variableName, opName),
+									unusedVariableMessage(context.messageFormatter(), variableName, opName),
 									[]ast.Node{variableDef},
 								)
 							}
@@ -1135,60 +1662,325 @@ func NoUnusedVariablesRule(context *ValidationContext) *ValidationRuleInstance {
 	}
 }
 
-func getFragmentType(context *ValidationContext, name string) Type {
-	frag := context.Fragment(name)
-	if frag == nil {
-		return nil
-	}
-	ttype, _ := typeFromAST(*context.Schema(), frag.TypeCondition)
-	return ttype
-}
-
-func doTypesOverlap(schema *Schema, t1 Type, t2 Type) bool {
-	if t1 == t2 {
-		return true
+// NoVariablesInConstantsRule No variables in constant values
+//
+// A GraphQL document is only valid if variables are only used within
+// operations and fragments, and not in values which must be constant: the
+// default values of variables, and the arguments of directives applied in
+// type system definitions, e.g. `@deprecated(reason: $reason)`.
+func NoVariablesInConstantsRule(context *ValidationContext) *ValidationRuleInstance {
+	// executable is whether the visit is within an operation or a fragment,
+	// and varDef the variable definition it is within, if any.
+	executable := false
+	var varDef *ast.VariableDefinition
+
+	enterExecutable := visitor.NamedVisitFuncs{
+		Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+			executable = true
+			return visitor.ActionNoChange, nil
+		},
+		Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+			executable = false
+			return visitor.ActionNoChange, nil
+		},
 	}
-	if _, ok := t1.(*Object); ok {
-		if _, ok := t2.(*Object); ok {
-			return false
-		}
-		if t2, ok := t2.(Abstract); ok {
-			for _, ttype := range schema.PossibleTypes(t2) {
-				if ttype == t1 {
-					return true
-				}
-			}
-			return false
-		}
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.OperationDefinition: enterExecutable,
+			kinds.FragmentDefinition:  enterExecutable,
+			kinds.VariableDefinition: {
+				Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+					varDef, _ = p.Node.(*ast.VariableDefinition)
+					return visitor.ActionNoChange, nil
+				},
+				Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+					varDef = nil
+					return visitor.ActionNoChange, nil
+				},
+			},
+			kinds.Variable: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.Variable)
+					if !ok || node == nil || node.Name == nil {
+						return visitor.ActionNoChange, nil
+					}
+					// The variable a definition defines is not a value.
+					if varDef != nil && varDef.Variable == node && p.Key == "Variable" {
+						return visitor.ActionNoChange, nil
+					}
+					if !executable || varDef != nil {
+						reportError(
+							context,
+							context.FormatMessage("NoVariablesInConstants", node.Name.Value),
+							[]ast.Node{node},
+						)
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+		},
 	}
-	if t1, ok := t1.(Abstract); ok {
-		if _, ok := t2.(*Object); ok {
-			for _, ttype := range schema.PossibleTypes(t1) {
-				if ttype == t2 {
-					return true
-				}
-			}
-			return false
-		}
-		t1TypeNames := map[string]bool{}
-		for _, ttype := range schema.PossibleTypes(t1) {
-			t1TypeNames[ttype.Name()] = true
-		}
-		if t2, ok := t2.(Abstract); ok {
-			for _, ttype := range schema.PossibleTypes(t2) {
-				if hasT1TypeName, _ := t1TypeNames[ttype.Name()]; hasT1TypeName {
-					return true
-				}
-			}
-			return false
-		}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
 	}
-	return false
 }
 
-// PossibleFragmentSpreadsRule Possible fragment spread
+// NewNonEmptyOperationRule Non-empty operation
 //
-// A fragment spread is only valid if the type condition could ever possibly
+// A GraphQL document is only valid if each operation selects at least one
+// field once its fragments are expanded. An operation whose selections are
+// all spreads of unknown or empty fragments, or which has no selection at
+// all in a document built programmatically, would always get an empty result.
+func NewNonEmptyOperationRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						operation, ok := p.Node.(*ast.OperationDefinition)
+						if !ok || operation == nil {
+							return visitor.ActionNoChange, nil
+						}
+						rootType, _ := RootType(context.Schema(), operation)
+						if len(CollectFields(context, rootType, operation.SelectionSet)) > 0 {
+							return visitor.ActionSkip, nil
+						}
+						message := context.FormatMessage("NonEmptyOperation.Anonymous")
+						if operation.Name != nil {
+							message = context.FormatMessage("NonEmptyOperation", operation.Name.Value)
+						}
+						reportError(context, message, []ast.Node{operation})
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// NewNonEmptySelectionSetRule Non-empty selection set
+//
+// A GraphQL document is only valid if each field of a composite type selects
+// at least one subfield. The parser rejects `user {}`, so this matters for
+// documents built or transformed programmatically; ScalarLeafsRule only
+// checks that the selection set is present.
+func NewNonEmptySelectionSetRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.Field)
+						if !ok || node == nil || node.SelectionSet == nil || len(node.SelectionSet.Selections) > 0 {
+							return visitor.ActionNoChange, nil
+						}
+						if !IsCompositeType(GetNamed(context.Type())) {
+							return visitor.ActionNoChange, nil
+						}
+						nodeName := ""
+						if node.Name != nil {
+							nodeName = node.Name.Value
+						}
+						return reportError(
+							context,
+							context.FormatMessage("NonEmptySelectionSet", nodeName),
+							[]ast.Node{node},
+						)
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// RootType Returns the root type of the schema for the kind of the operation:
+// the query, mutation or subscription type, or a validation error located at
+// the operation when the schema doesn't define that root type.
+func RootType(schema *Schema, op *ast.OperationDefinition) (Named, error) {
+	return rootType(DefaultMessageTemplates, schema, op)
+}
+
+func rootType(formatter MessageFormatter, schema *Schema, op *ast.OperationDefinition) (Named, error) {
+	if schema == nil || op == nil {
+		return nil, newValidationError(formatter.Format("OperationTypeExists.Unknown"), nil)
+	}
+	switch op.Operation {
+	case ast.OperationTypeQuery:
+		if queryType := schema.QueryType(); queryType != nil {
+			return queryType, nil
+		}
+		return nil, newValidationError(formatter.Format("OperationTypeExists.Query"), []ast.Node{op})
+	case ast.OperationTypeMutation:
+		if mutationType := schema.MutationType(); mutationType != nil {
+			return mutationType, nil
+		}
+		return nil, newValidationError(formatter.Format("OperationTypeExists.Mutation"), []ast.Node{op})
+	case ast.OperationTypeSubscription:
+		if subscriptionType := schema.SubscriptionType(); subscriptionType != nil {
+			return subscriptionType, nil
+		}
+		return nil, newValidationError(formatter.Format("OperationTypeExists.Subscription"), []ast.Node{op})
+	}
+	return nil, newValidationError(formatter.Format("OperationTypeExists.Unknown"), []ast.Node{op})
+}
+
+// NewOperationTypeExistsRule Operation type exists
+//
+// A GraphQL document is only valid if the schema defines a root type for each
+// kind of operation it contains: a mutation needs a mutation type and a
+// subscription needs a subscription type.
+func NewOperationTypeExistsRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if node, ok := p.Node.(*ast.OperationDefinition); ok && node != nil {
+							if _, err := rootType(context.messageFormatter(), context.Schema(), node); err != nil {
+								context.ReportError(err)
+							}
+						}
+						return visitor.ActionSkip, nil
+					},
+				},
+				kinds.FragmentDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+func getFragmentType(context *ValidationContext, name string) Type {
+	frag := context.Fragment(name)
+	if frag == nil {
+		return nil
+	}
+	ttype, _ := context.typeFromAST(frag.TypeCondition)
+	return ttype
+}
+
+func doTypesOverlap(schema *Schema, t1 Type, t2 Type) bool {
+	return typesOverlap(func(ttype Abstract) map[string]bool {
+		return possibleTypeNames(schema, ttype)
+	}, t1, t2)
+}
+
+// typesOverlap is doTypesOverlap looking up the names of the possible types
+// of abstract types through possibleTypeNames, so that they can be cached.
+func typesOverlap(possibleTypeNames func(ttype Abstract) map[string]bool, t1 Type, t2 Type) bool {
+	if t1 == t2 {
+		return true
+	}
+	if t1, ok := t1.(*Object); ok {
+		if _, ok := t2.(*Object); ok {
+			return false
+		}
+		if t2, ok := t2.(Abstract); ok {
+			return possibleTypeNames(t2)[t1.Name()]
+		}
+	}
+	if t1, ok := t1.(Abstract); ok {
+		t1TypeNames := possibleTypeNames(t1)
+		if t2, ok := t2.(*Object); ok {
+			return t1TypeNames[t2.Name()]
+		}
+		if t2, ok := t2.(Abstract); ok {
+			for typeName := range possibleTypeNames(t2) {
+				if t1TypeNames[typeName] {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return false
+}
+
+func possibleTypeNames(schema *Schema, ttype Abstract) map[string]bool {
+	typeNames := map[string]bool{}
+	for _, possibleType := range schema.PossibleTypes(ttype) {
+		typeNames[possibleType.Name()] = true
+	}
+	return typeNames
+}
+
+// PersistedOperationHash returns the key of the operation of the document in
+// the allowlist of NewPersistedOperationsRule: the hex encoded SHA-256 hash of
+// the printed operation definition, followed by the fragment definitions it
+// spreads, directly or through other fragments, sorted by name.
+func PersistedOperationHash(doc *ast.Document, operation *ast.OperationDefinition) string {
+	context := NewValidationContext(nil, doc, nil)
+	return persistedOperationHash(operation, context.RecursivelyReferencedFragments(operation))
+}
+
+func persistedOperationHash(operation *ast.OperationDefinition, fragments []*ast.FragmentDefinition) string {
+	fragments = append([]*ast.FragmentDefinition{}, fragments...)
+	name := func(fragment *ast.FragmentDefinition) string {
+		if fragment.Name == nil {
+			return ""
+		}
+		return fragment.Name.Value
+	}
+	sort.Slice(fragments, func(i, j int) bool {
+		return name(fragments[i]) < name(fragments[j])
+	})
+	printed := []string{fmt.Sprintf("%v", printer.Print(operation))}
+	for _, fragment := range fragments {
+		printed = append(printed, fmt.Sprintf("%v", printer.Print(fragment)))
+	}
+	sum := sha256.Sum256([]byte(strings.Join(printed, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewPersistedOperationsRule Persisted operations
+//
+// A GraphQL document is only valid if each of its operations is in the given
+// allowlist of persisted operations, keyed by PersistedOperationHash, which
+// covers the fragments the operation spreads. This locks a production server
+// down to the operations its clients shipped with.
+func NewPersistedOperationsRule(allowed map[string]bool) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						operation, ok := p.Node.(*ast.OperationDefinition)
+						if !ok || operation == nil {
+							return visitor.ActionNoChange, nil
+						}
+						if !allowed[persistedOperationHash(operation, context.RecursivelyReferencedFragments(operation))] {
+							reportError(
+								context,
+								context.FormatMessage("PersistedOperations"),
+								[]ast.Node{operation},
+							)
+						}
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// PossibleFragmentSpreadsRule Possible fragment spread
+//
+// A fragment spread is only valid if the type condition could ever possibly
 // be true: if there is a non-empty intersection of the possible parent types,
 // and possible types which pass the type condition.
 func PossibleFragmentSpreadsRule(context *ValidationContext) *ValidationRuleInstance {
@@ -1201,11 +1993,10 @@ func PossibleFragmentSpreadsRule(context *ValidationContext) *ValidationRuleInst
 						fragType := context.Type()
 						parentType, _ := context.ParentType().(Type)
 
-						if fragType != nil && parentType != nil && !doTypesOverlap(context.Schema(), fragType, parentType) {
+						if fragType != nil && parentType != nil && !typesOverlap(context.possibleTypeNames, fragType, parentType) {
 							reportError(
 								context,
-								fmt.Sprintf(`Fragment cannot be spread here as objects of `+
-									`type "%v" can never be of type "%v".`, parentType, fragType),
+								context.FormatMessage("PossibleFragmentSpreads.Inline", parentType, fragType),
 								[]ast.Node{node},
 							)
 						}
@@ -1222,11 +2013,10 @@ func PossibleFragmentSpreadsRule(context *ValidationContext) *ValidationRuleInst
 						}
 						fragType := getFragmentType(context, fragName)
 						parentType, _ := context.ParentType().(Type)
-						if fragType != nil && parentType != nil && !doTypesOverlap(context.Schema(), fragType, parentType) {
+						if fragType != nil && parentType != nil && !typesOverlap(context.possibleTypeNames, fragType, parentType) {
 							reportError(
 								context,
-								fmt.Sprintf(`Fragment "%v" cannot be spread here as objects of `+
-									`type "%v" can never be of type "%v".`, fragName, parentType, fragType),
+								context.FormatMessage("PossibleFragmentSpreads", fragName, parentType, fragType),
 								[]ast.Node{node},
 							)
 						}
@@ -1241,6 +2031,827 @@ func PossibleFragmentSpreadsRule(context *ValidationContext) *ValidationRuleInst
 	}
 }
 
+// NewRedundantInlineFragmentRule Redundant inline fragment
+//
+// A lint rule which warns about inline fragments whose type condition is the
+// type of the enclosing selection set, since such a fragment always applies
+// and its type condition can be removed.
+func NewRedundantInlineFragmentRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.InlineFragment: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.InlineFragment)
+						if !ok || node == nil || node.TypeCondition == nil || node.TypeCondition.Name == nil {
+							return visitor.ActionNoChange, nil
+						}
+						parentType := context.ParentType()
+						if parentType == nil || reflect.ValueOf(parentType).IsNil() {
+							return visitor.ActionNoChange, nil
+						}
+						if typeName := node.TypeCondition.Name.Value; typeName == parentType.Name() {
+							return reportWarning(
+								context,
+								context.FormatMessage("RedundantInlineFragment", typeName, parentType.Name()),
+								[]ast.Node{node},
+							)
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// requireDescriptionsKinds The kinds of definitions NewRequireDescriptionsRule
+// checks by default.
+var requireDescriptionsKinds = []string{
+	kinds.ScalarDefinition,
+	kinds.ObjectDefinition,
+	kinds.InterfaceDefinition,
+	kinds.UnionDefinition,
+	kinds.EnumDefinition,
+	kinds.InputObjectDefinition,
+	kinds.FieldDefinition,
+	kinds.InputValueDefinition,
+}
+
+// NewRequireDescriptionsRule Require descriptions
+//
+// A lint rule for type system documents which warns about definitions without
+// a description, to gate the quality of a schema's documentation. It checks
+// the definitions of the given kinds, e.g. kinds.FieldDefinition, or else the
+// type, field and argument definitions. Types are described where they are
+// defined, not where they are extended.
+func NewRequireDescriptionsRule(nodeKinds ...string) ValidationRuleFn {
+	if len(nodeKinds) == 0 {
+		nodeKinds = requireDescriptionsKinds
+	}
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		isChecked := map[string]bool{}
+		for _, kind := range nodeKinds {
+			isChecked[kind] = true
+		}
+		hasDescription := func(node ast.Node) bool {
+			describable, ok := node.(ast.DescribableNode)
+			return !ok || describable.GetDescription() != nil
+		}
+		// The names of the enclosing definitions, to name the definition
+		// missing a description.
+		typeName, fieldName, directiveName := "", "", ""
+
+		typeDefinition := visitor.NamedVisitFuncs{
+			Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+				var nameAST *ast.Name
+				switch node := p.Node.(type) {
+				case *ast.ScalarDefinition:
+					nameAST = node.Name
+				case *ast.ObjectDefinition:
+					nameAST = node.Name
+				case *ast.InterfaceDefinition:
+					nameAST = node.Name
+				case *ast.UnionDefinition:
+					nameAST = node.Name
+				case *ast.EnumDefinition:
+					nameAST = node.Name
+				case *ast.InputObjectDefinition:
+					nameAST = node.Name
+				}
+				if nameAST == nil {
+					return visitor.ActionSkip, nil
+				}
+				typeName = nameAST.Value
+				node := p.Node.(ast.Node)
+				if _, isExtension := p.Parent.(*ast.TypeExtensionDefinition); isExtension ||
+					!isChecked[node.GetKind()] || hasDescription(node) {
+					return visitor.ActionNoChange, nil
+				}
+				return reportWarning(
+					context,
+					context.FormatMessage("RequireDescriptions.Type", typeName),
+					[]ast.Node{nameAST},
+				)
+			},
+			Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+				typeName = ""
+				return visitor.ActionNoChange, nil
+			},
+		}
+
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.ScalarDefinition:      typeDefinition,
+				kinds.ObjectDefinition:      typeDefinition,
+				kinds.InterfaceDefinition:   typeDefinition,
+				kinds.UnionDefinition:       typeDefinition,
+				kinds.EnumDefinition:        typeDefinition,
+				kinds.InputObjectDefinition: typeDefinition,
+				kinds.DirectiveDefinition: {
+					Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if node, ok := p.Node.(*ast.DirectiveDefinition); ok && node != nil && node.Name != nil {
+							directiveName = node.Name.Value
+						}
+						return visitor.ActionNoChange, nil
+					},
+					Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+						directiveName = ""
+						return visitor.ActionNoChange, nil
+					},
+				},
+				kinds.FieldDefinition: {
+					Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.FieldDefinition)
+						if !ok || node == nil || node.Name == nil {
+							return visitor.ActionSkip, nil
+						}
+						fieldName = node.Name.Value
+						if !isChecked[kinds.FieldDefinition] || hasDescription(node) {
+							return visitor.ActionNoChange, nil
+						}
+						return reportWarning(
+							context,
+							context.FormatMessage("RequireDescriptions.Field", typeName, fieldName),
+							[]ast.Node{node.Name},
+						)
+					},
+					Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+						fieldName = ""
+						return visitor.ActionNoChange, nil
+					},
+				},
+				kinds.InputValueDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.InputValueDefinition)
+						if !ok || node == nil || node.Name == nil {
+							return visitor.ActionSkip, nil
+						}
+						if !isChecked[kinds.InputValueDefinition] || hasDescription(node) {
+							return visitor.ActionSkip, nil
+						}
+						var message string
+						switch {
+						case directiveName != "":
+							message = context.FormatMessage("RequireDescriptions.DirectiveArgument", directiveName, node.Name.Value)
+						case fieldName != "":
+							message = context.FormatMessage("RequireDescriptions.Argument", typeName, fieldName, node.Name.Value)
+						default:
+							// A field of an input object type.
+							message = context.FormatMessage("RequireDescriptions.Field", typeName, node.Name.Value)
+						}
+						reportWarning(context, message, []ast.Node{node.Name})
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// NewRequireDirectiveOnTypesRule Require directive on types
+//
+// A type system document is only valid if each of the given object types,
+// e.g. the entities of a federated subgraph, declares the given directive on
+// its definition or on one of its extensions. Types the document doesn't
+// define are ignored.
+func NewRequireDirectiveOnTypesRule(typeNames []string, directiveName string) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		requiredTypes := map[string]bool{}
+		for _, name := range typeNames {
+			requiredTypes[name] = true
+		}
+		definitions := map[string][]*ast.ObjectDefinition{}
+		definedTypeNames := []string{}
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.ObjectDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.ObjectDefinition)
+						if !ok || node == nil || node.Name == nil || !requiredTypes[node.Name.Value] {
+							return visitor.ActionSkip, nil
+						}
+						if _, ok := definitions[node.Name.Value]; !ok {
+							definedTypeNames = append(definedTypeNames, node.Name.Value)
+						}
+						definitions[node.Name.Value] = append(definitions[node.Name.Value], node)
+						return visitor.ActionSkip, nil
+					},
+				},
+				kinds.Document: {
+					Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+						for _, typeName := range definedTypeNames {
+							hasDirective := false
+							for _, def := range definitions[typeName] {
+								for _, directive := range def.Directives {
+									if directive != nil && directive.Name != nil && directive.Name.Value == directiveName {
+										hasDirective = true
+									}
+								}
+							}
+							if !hasDirective {
+								reportError(
+									context,
+									context.FormatMessage("RequireDirectiveOnTypes", typeName, directiveName),
+									[]ast.Node{definitions[typeName][0]},
+								)
+							}
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// paginationArgNames The arguments of Relay-style connection fields.
+var paginationArgNames = []string{"first", "after", "last", "before"}
+
+// NewRequirePaginationArgsRule Require pagination arguments
+//
+// A GraphQL document is only valid if each of the given connection fields is
+// selected with at least one of the "first", "after", "last" or "before"
+// arguments, so that it doesn't fetch the whole connection.
+func NewRequirePaginationArgsRule(connectionFields []string) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		isConnectionField := map[string]bool{}
+		for _, fieldName := range connectionFields {
+			isConnectionField[fieldName] = true
+		}
+		isPaginationArg := map[string]bool{}
+		for _, argName := range paginationArgNames {
+			isPaginationArg[argName] = true
+		}
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						fieldAST, ok := p.Node.(*ast.Field)
+						if !ok || fieldAST == nil || fieldAST.Name == nil || !isConnectionField[fieldAST.Name.Value] {
+							return visitor.ActionNoChange, nil
+						}
+						for _, argAST := range fieldAST.Arguments {
+							if argAST != nil && argAST.Name != nil && isPaginationArg[argAST.Name.Value] {
+								return visitor.ActionNoChange, nil
+							}
+						}
+						return reportError(
+							context,
+							context.FormatMessage("RequirePaginationArgs", fieldAST.Name.Value),
+							[]ast.Node{fieldAST},
+						)
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// NewRequireTypenameOnAbstractRule Require __typename on abstract types
+//
+// A lint rule which warns about selection sets on interface or union types
+// which don't select "__typename", which clients normalizing their cache by
+// type need to tell the concrete type of each object.
+func NewRequireTypenameOnAbstractRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.SelectionSet: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.SelectionSet)
+						if !ok || node == nil {
+							return visitor.ActionNoChange, nil
+						}
+						var parentType Abstract
+						switch ttype := context.ParentType().(type) {
+						case *Interface:
+							if ttype != nil {
+								parentType = ttype
+							}
+						case *Union:
+							if ttype != nil {
+								parentType = ttype
+							}
+						}
+						if parentType == nil {
+							return visitor.ActionNoChange, nil
+						}
+						for _, selection := range node.Selections {
+							if field, ok := selection.(*ast.Field); ok && field.Name != nil && field.Name.Value == TypeNameMetaFieldDef.Name {
+								return visitor.ActionNoChange, nil
+							}
+						}
+						// Locate the warning at the field or fragment selecting
+						// the abstract type.
+						var location ast.Node = node
+						if parent, ok := p.Parent.(ast.Node); ok && parent != nil {
+							location = parent
+						}
+						return reportWarning(
+							context,
+							context.FormatMessage("RequireTypenameOnAbstract", parentType.Name()),
+							[]ast.Node{location},
+						)
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// sdlKnownTypes Returns the types known while validating a type system
+// document, those it defines, the built-in types and the types of the schema
+// it extends, by name along with whether they are object types, and the name
+// nodes of the types the document defines.
+func sdlKnownTypes(context *ValidationContext) (map[string]bool, map[string]*ast.Name) {
+	isObjectType := map[string]bool{}
+	definedNameASTs := map[string]*ast.Name{}
+	existingTypes := builtInTypes()
+	if schema := context.Schema(); schema != nil {
+		for _, ttype := range schema.TypeMap() {
+			existingTypes = append(existingTypes, ttype)
+		}
+	}
+	for _, ttype := range existingTypes {
+		_, isObject := ttype.(*Object)
+		isObjectType[ttype.Name()] = isObject
+	}
+	for _, definition := range context.Document().Definitions {
+		var nameAST *ast.Name
+		isObject := false
+		switch definition := definition.(type) {
+		case *ast.ScalarDefinition:
+			nameAST = definition.Name
+		case *ast.ObjectDefinition:
+			nameAST, isObject = definition.Name, true
+		case *ast.InterfaceDefinition:
+			nameAST = definition.Name
+		case *ast.UnionDefinition:
+			nameAST = definition.Name
+		case *ast.EnumDefinition:
+			nameAST = definition.Name
+		case *ast.InputObjectDefinition:
+			nameAST = definition.Name
+		}
+		if nameAST != nil {
+			isObjectType[nameAST.Value] = isObject
+			definedNameASTs[nameAST.Value] = nameAST
+		}
+	}
+	return isObjectType, definedNameASTs
+}
+
+// ObjectImplementsInterfaceRule Object implements interface
+//
+// A type system document is only valid if each object type provides all the
+// fields of the interfaces it implements, with a return type which is a valid
+// subtype of the interface field's type, the same arguments of equal types,
+// and no additional required arguments. Like the schema's own validation, but
+// on the document, also considering the types of the schema it extends.
+func ObjectImplementsInterfaceRule(context *ValidationContext) *ValidationRuleInstance {
+	types := newSDLTypes(context.Schema(), context.Document())
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.Document: {
+				Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+					for _, objectName := range types.definedObjectNames {
+						for _, ifaceAST := range types.interfaces[objectName] {
+							if _, ok := types.schema.Type(ifaceAST.Name.Value).(*Interface); !ok {
+								continue
+							}
+							checkObjectImplementsInterface(context, types, objectName, ifaceAST)
+						}
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+func checkObjectImplementsInterface(context *ValidationContext, types *sdlTypes, objectName string, ifaceAST *ast.Named) {
+	ifaceName := ifaceAST.Name.Value
+	// Locate the errors at the definitions in the document, or else at the
+	// interface in the implements clause.
+	nodesOf := func(defs ...ast.Node) []ast.Node {
+		nodes := []ast.Node{}
+		for _, def := range defs {
+			if def != nil {
+				nodes = append(nodes, def)
+			}
+		}
+		if len(nodes) == 0 {
+			nodes = append(nodes, ifaceAST)
+		}
+		return nodes
+	}
+
+	for _, ifaceField := range types.fields[ifaceName] {
+		objectField := types.field(objectName, ifaceField.name)
+		if objectField == nil {
+			reportError(
+				context,
+				context.FormatMessage("ObjectImplementsInterface.MissingField", ifaceName, ifaceField.name, objectName),
+				nodesOf(ifaceField.node, ifaceAST),
+			)
+			continue
+		}
+
+		if !isTypeSubTypeOf(types.schema, objectField.ttype, ifaceField.ttype) {
+			reportError(
+				context,
+				context.FormatMessage("ObjectImplementsInterface.FieldType",
+					ifaceName, ifaceField.name, ifaceField.ttype,
+					objectName, objectField.name, objectField.ttype),
+				nodesOf(ifaceField.node, objectField.node),
+			)
+		}
+
+		for _, ifaceArg := range ifaceField.args {
+			objectArg := objectField.arg(ifaceArg.name)
+			if objectArg == nil {
+				reportError(
+					context,
+					context.FormatMessage("ObjectImplementsInterface.MissingArgument",
+						ifaceName, ifaceField.name, ifaceArg.name, objectName, objectField.name),
+					nodesOf(ifaceArg.node, objectField.node),
+				)
+				continue
+			}
+			if !isEqualType(objectArg.ttype, ifaceArg.ttype) {
+				reportError(
+					context,
+					context.FormatMessage("ObjectImplementsInterface.ArgumentType",
+						ifaceName, ifaceField.name, ifaceArg.name, ifaceArg.ttype,
+						objectName, objectField.name, objectArg.name, objectArg.ttype),
+					nodesOf(ifaceArg.node, objectArg.node),
+				)
+			}
+		}
+
+		for _, objectArg := range objectField.args {
+			if _, ok := objectArg.ttype.(*NonNull); ok && ifaceField.arg(objectArg.name) == nil {
+				reportError(
+					context,
+					context.FormatMessage("ObjectImplementsInterface.RequiredArgument",
+						objectName, objectField.name, objectArg.name, ifaceName, ifaceField.name),
+					nodesOf(objectArg.node, ifaceField.node),
+				)
+			}
+		}
+	}
+}
+
+// sdlField A field of an object or interface type, defined by a type system
+// document or by the schema it extends.
+type sdlField struct {
+	name  string
+	ttype Type
+	args  []*sdlArgument
+	// node is the definition of the field in the document, nil for a field
+	// of the schema.
+	node ast.Node
+}
+
+type sdlArgument struct {
+	name  string
+	ttype Type
+	node  ast.Node
+}
+
+func (field *sdlField) arg(name string) *sdlArgument {
+	for _, arg := range field.args {
+		if arg.name == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+// sdlTypes The types known while validating a type system document: those of
+// the schema it extends, merged with those the document defines or extends.
+type sdlTypes struct {
+	// schema holds the named types, with the possible types of the abstract
+	// ones, so that the field types compare with isTypeSubTypeOf. The types
+	// the document defines only stand for their name and kind.
+	schema *Schema
+	// fields maps the name of an object or interface type to its fields.
+	fields map[string][]*sdlField
+	// interfaces maps the name of an object type to the interfaces it
+	// implements, each listed once.
+	interfaces map[string][]*ast.Named
+	// definedObjectNames lists the object types the document defines or
+	// extends, in document order.
+	definedObjectNames []string
+}
+
+func newSDLTypes(schema *Schema, doc *ast.Document) *sdlTypes {
+	types := &sdlTypes{
+		schema: &Schema{
+			typeMap:         TypeMap{},
+			possibleTypeMap: map[string]map[string]bool{},
+		},
+		fields:     map[string][]*sdlField{},
+		interfaces: map[string][]*ast.Named{},
+	}
+	if schema != nil {
+		for typeName, ttype := range schema.TypeMap() {
+			types.schema.typeMap[typeName] = ttype
+			switch ttype := ttype.(type) {
+			case *Object:
+				types.addSchemaFields(typeName, ttype.Fields())
+				for _, iface := range ttype.Interfaces() {
+					types.addInterface(typeName, ast.NewNamed(&ast.Named{
+						Name: ast.NewName(&ast.Name{Value: iface.Name()}),
+					}))
+				}
+			case *Interface:
+				types.possibleTypes(typeName)
+				types.addSchemaFields(typeName, ttype.Fields())
+			case *Union:
+				for _, member := range ttype.Types() {
+					types.possibleTypes(typeName)[member.Name()] = true
+				}
+			}
+		}
+	}
+	if doc == nil {
+		return types
+	}
+
+	// Define the types first, so that the fields can refer to any of them.
+	definitions := []ast.Node{}
+	for _, definition := range doc.Definitions {
+		if extension, ok := definition.(*ast.TypeExtensionDefinition); ok && extension != nil {
+			definition = extension.Definition
+		}
+		definitions = append(definitions, definition)
+		switch definition := definition.(type) {
+		case *ast.ObjectDefinition:
+			if definition == nil || definition.Name == nil {
+				continue
+			}
+			types.define(NewObject(ObjectConfig{Name: definition.Name.Value, Fields: Fields{}}))
+		case *ast.InterfaceDefinition:
+			if definition == nil || definition.Name == nil {
+				continue
+			}
+			types.define(NewInterface(InterfaceConfig{Name: definition.Name.Value, Fields: Fields{}}))
+			types.possibleTypes(definition.Name.Value)
+		case *ast.UnionDefinition:
+			if definition == nil || definition.Name == nil {
+				continue
+			}
+			types.define(NewUnion(UnionConfig{Name: definition.Name.Value}))
+			for _, member := range definition.Types {
+				if member != nil && member.Name != nil {
+					types.possibleTypes(definition.Name.Value)[member.Name.Value] = true
+				}
+			}
+		}
+	}
+
+	definedObjects := map[string]bool{}
+	for _, definition := range definitions {
+		switch definition := definition.(type) {
+		case *ast.ObjectDefinition:
+			if definition == nil || definition.Name == nil {
+				continue
+			}
+			typeName := definition.Name.Value
+			types.addFields(typeName, definition.Fields)
+			for _, ifaceAST := range definition.Interfaces {
+				types.addInterface(typeName, ifaceAST)
+			}
+			if !definedObjects[typeName] {
+				definedObjects[typeName] = true
+				types.definedObjectNames = append(types.definedObjectNames, typeName)
+			}
+		case *ast.InterfaceDefinition:
+			if definition == nil || definition.Name == nil {
+				continue
+			}
+			types.addFields(definition.Name.Value, definition.Fields)
+		}
+	}
+	return types
+}
+
+// define Adds a type the document defines, unless the schema already has it.
+func (types *sdlTypes) define(ttype Type) {
+	if _, ok := types.schema.typeMap[ttype.Name()]; !ok {
+		types.schema.typeMap[ttype.Name()] = ttype
+	}
+}
+
+// named Returns the named type of the given name, standing in a scalar for a
+// type neither the schema nor the document defines, so that its uses still
+// compare equal to each other.
+func (types *sdlTypes) named(name string) Type {
+	ttype, ok := types.schema.typeMap[name]
+	if !ok {
+		ttype = NewScalar(ScalarConfig{Name: name})
+		types.schema.typeMap[name] = ttype
+	}
+	return ttype
+}
+
+// possibleTypes Returns the set of the possible object types of the abstract
+// type of the given name.
+func (types *sdlTypes) possibleTypes(abstractName string) map[string]bool {
+	possibleTypes, ok := types.schema.possibleTypeMap[abstractName]
+	if !ok {
+		possibleTypes = map[string]bool{}
+		types.schema.possibleTypeMap[abstractName] = possibleTypes
+	}
+	return possibleTypes
+}
+
+// addInterface Records that the object type implements the interface, unless
+// the schema or an earlier definition or extension already declares it.
+func (types *sdlTypes) addInterface(objectName string, ifaceAST *ast.Named) {
+	if ifaceAST == nil || ifaceAST.Name == nil {
+		return
+	}
+	possibleTypes := types.possibleTypes(ifaceAST.Name.Value)
+	if possibleTypes[objectName] {
+		return
+	}
+	possibleTypes[objectName] = true
+	types.interfaces[objectName] = append(types.interfaces[objectName], ifaceAST)
+}
+
+func (types *sdlTypes) addSchemaFields(typeName string, fieldMap FieldDefinitionMap) {
+	fieldNames := []string{}
+	for fieldName := range fieldMap {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		fieldDef := fieldMap[fieldName]
+		field := &sdlField{
+			name:  fieldName,
+			ttype: fieldDef.Type,
+		}
+		for _, arg := range fieldDef.Args {
+			field.args = append(field.args, &sdlArgument{
+				name:  arg.PrivateName,
+				ttype: arg.Type,
+			})
+		}
+		types.fields[typeName] = append(types.fields[typeName], field)
+	}
+}
+
+func (types *sdlTypes) addFields(typeName string, fieldDefs []*ast.FieldDefinition) {
+	for _, fieldDef := range fieldDefs {
+		if fieldDef == nil || fieldDef.Name == nil || types.field(typeName, fieldDef.Name.Value) != nil {
+			continue
+		}
+		ttype, err := typeFromASTWithResolver(types.named, fieldDef.Type)
+		if err != nil {
+			continue
+		}
+		field := &sdlField{
+			name:  fieldDef.Name.Value,
+			ttype: ttype,
+			node:  fieldDef,
+		}
+		for _, argDef := range fieldDef.Arguments {
+			if argDef == nil || argDef.Name == nil {
+				continue
+			}
+			argType, err := typeFromASTWithResolver(types.named, argDef.Type)
+			if err != nil {
+				continue
+			}
+			field.args = append(field.args, &sdlArgument{
+				name:  argDef.Name.Value,
+				ttype: argType,
+				node:  argDef,
+			})
+		}
+		types.fields[typeName] = append(types.fields[typeName], field)
+	}
+}
+
+func (types *sdlTypes) field(typeName string, fieldName string) *sdlField {
+	for _, field := range types.fields[typeName] {
+		if field.name == fieldName {
+			return field
+		}
+	}
+	return nil
+}
+
+// PossibleTypeExtensionsRule Possible type extensions
+//
+// A type system document is only valid if each extended type is defined, by
+// the document or by the schema it extends, and is an object type, the only
+// kind of type which can be extended.
+func PossibleTypeExtensionsRule(context *ValidationContext) *ValidationRuleInstance {
+	isObjectType, definedNameASTs := sdlKnownTypes(context)
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.TypeExtensionDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.TypeExtensionDefinition)
+					if !ok || node == nil || node.Definition == nil || node.Definition.Name == nil {
+						return visitor.ActionSkip, nil
+					}
+					typeName := node.Definition.Name.Value
+					isObject, ok := isObjectType[typeName]
+					if !ok {
+						typeNames := []string{}
+						for name := range isObjectType {
+							typeNames = append(typeNames, name)
+						}
+						message := context.FormatMessage("PossibleTypeExtensions.NotDefined", typeName)
+						if suggestedTypes := context.SuggestionList(typeName, typeNames); len(suggestedTypes) > 0 {
+							message = context.FormatMessage("DidYouMean", message, quotedOrList(suggestedTypes))
+						}
+						reportError(context, message, []ast.Node{node.Definition.Name})
+					} else if !isObject {
+						nodes := []ast.Node{node.Definition.Name}
+						if nameAST, ok := definedNameASTs[typeName]; ok {
+							nodes = []ast.Node{nameAST, node.Definition.Name}
+						}
+						reportError(
+							context,
+							context.FormatMessage("PossibleTypeExtensions.NonObject", typeName),
+							nodes,
+						)
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// NewPreferReplacementFieldRule Prefer replacement field
+//
+// A lint rule which warns about selections of deprecated fields which have a
+// replacement, suggesting to select the replacement instead. The replacements
+// are given by the deprecated fields' coordinates, e.g. "User.fullName", and
+// name a field of the same type, e.g. "name".
+func NewPreferReplacementFieldRule(replacements map[string]string) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.Field)
+						if !ok || node == nil || node.Name == nil {
+							return visitor.ActionNoChange, nil
+						}
+						parentType := context.ParentType()
+						if parentType == nil || reflect.ValueOf(parentType).IsNil() {
+							return visitor.ActionNoChange, nil
+						}
+						replacement, ok := replacements[parentType.Name()+"."+node.Name.Value]
+						if !ok {
+							return visitor.ActionNoChange, nil
+						}
+						return reportWarning(
+							context,
+							context.FormatMessage("PreferReplacementField", parentType.Name(), node.Name.Value, replacement),
+							[]ast.Node{node},
+						)
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
 // ProvidedNonNullArgumentsRule Provided required arguments
 //
 // A field or directive is only valid if all required (non-null) field arguments
@@ -1278,8 +2889,7 @@ func ProvidedNonNullArgumentsRule(context *ValidationContext) *ValidationRuleIns
 									}
 									reportError(
 										context,
-										fmt.Sprintf(`Field "%v" argument "%v" of type "%v" `+
-											`is required but not provided.`, fieldName, argDef.Name(), argDefType),
+										context.FormatMessage("ProvidedNonNullArguments", fieldName, argDef.Name(), argDefType),
 										[]ast.Node{fieldAST},
 									)
 								}
@@ -1319,8 +2929,7 @@ func ProvidedNonNullArgumentsRule(context *ValidationContext) *ValidationRuleIns
 									}
 									reportError(
 										context,
-										fmt.Sprintf(`Directive "@%v" argument "%v" of type `+
-											`"%v" is required but not provided.`, directiveName, argDef.Name(), argDefType),
+										context.FormatMessage("ProvidedNonNullArguments.Directive", directiveName, argDef.Name(), argDefType),
 										[]ast.Node{directiveAST},
 									)
 								}
@@ -1358,19 +2967,322 @@ func ScalarLeafsRule(context *ValidationContext) *ValidationRuleInstance {
 								if node.SelectionSet != nil {
 									reportError(
 										context,
-										fmt.Sprintf(`Field "%v" of type "%v" must not have a sub selection.`, nodeName, ttype),
+										context.FormatMessage("ScalarLeafs.NoSubselectionAllowed", nodeName, ttype),
 										[]ast.Node{node.SelectionSet},
 									)
 								}
 							} else if node.SelectionSet == nil {
 								reportError(
 									context,
-									fmt.Sprintf(`Field "%v" of type "%v" must have a sub selection.`, nodeName, ttype),
-									[]ast.Node{node},
+									context.FormatMessage("ScalarLeafs.RequiredSubselection", nodeName, ttype),
+									[]ast.Node{node},
+								)
+							}
+						}
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// SingleFieldSubscriptionsRule Subscriptions must only include one field.
+//
+// A GraphQL subscription is valid only if it contains a single root field,
+// which isn't an introspection field such as __typename. The root fields
+// include those selected through fragments.
+func SingleFieldSubscriptionsRule(context *ValidationContext) *ValidationRuleInstance {
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.OperationDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.OperationDefinition)
+					if !ok || node == nil || node.Operation != ast.OperationTypeSubscription || node.SelectionSet == nil {
+						return visitor.ActionSkip, nil
+					}
+					// The root fields, with the fragments expanded, by
+					// response name in document order.
+					var rootType Named
+					if context.Schema() != nil {
+						rootType, _ = RootType(context.Schema(), node)
+					}
+					fields := CollectFields(context, rootType, node.SelectionSet)
+					responseNames := []string{}
+					for responseName := range fields {
+						responseNames = append(responseNames, responseName)
+					}
+					start := func(responseName string) int {
+						if loc := fields[responseName][0].Field.Loc; loc != nil {
+							return loc.Start
+						}
+						return 0
+					}
+					sort.Slice(responseNames, func(i, j int) bool {
+						return start(responseNames[i]) < start(responseNames[j])
+					})
+					if len(responseNames) > 1 {
+						nodes := []ast.Node{}
+						for _, responseName := range responseNames[1:] {
+							for _, field := range fields[responseName] {
+								nodes = append(nodes, field.Field)
+							}
+						}
+						reportError(
+							context,
+							operationMessage(context, "SingleFieldSubscriptions", node),
+							nodes,
+						)
+					} else if len(responseNames) == 1 {
+						field := fields[responseNames[0]][0].Field
+						if field.Name != nil && strings.HasPrefix(field.Name.Value, "__") {
+							reportError(
+								context,
+								operationMessage(context, "SingleFieldSubscriptions.Introspection", node),
+								[]ast.Node{field},
+							)
+						}
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+			kinds.FragmentDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// operationMessage Formats the message with the given key for the
+// operation, using the ".Anonymous" variant of the key for anonymous ones.
+func operationMessage(context *ValidationContext, key string, operation *ast.OperationDefinition, args ...interface{}) string {
+	if operation.Name != nil && operation.Name.Value != "" {
+		return context.FormatMessage(key, append([]interface{}{operation.Name.Value}, args...)...)
+	}
+	return context.FormatMessage(key+".Anonymous", args...)
+}
+
+// NewSkipIncludeConflictRule Skip include conflict
+//
+// A lint rule which warns about fields and fragments using both @skip and
+// @include with constant conditions which contradict each other, e.g.
+// `@skip(if: true) @include(if: true)`, since such a selection is never
+// included. Conditions given by variables are not checked.
+func NewSkipIncludeConflictRule() ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		checkDirectives := func(p visitor.VisitFuncParams) (string, interface{}) {
+			var directives []*ast.Directive
+			switch node := p.Node.(type) {
+			case *ast.Field:
+				directives = node.Directives
+			case *ast.FragmentSpread:
+				directives = node.Directives
+			case *ast.InlineFragment:
+				directives = node.Directives
+			}
+			var skip, include *ast.Directive
+			var skipIf, includeIf bool
+			for _, directive := range directives {
+				if directive == nil || directive.Name == nil {
+					continue
+				}
+				value, ok := constantIfArgument(directive)
+				if !ok {
+					continue
+				}
+				switch directive.Name.Value {
+				case SkipDirective.Name:
+					skip, skipIf = directive, value
+				case IncludeDirective.Name:
+					include, includeIf = directive, value
+				}
+			}
+			if skip != nil && include != nil && skipIf == includeIf {
+				return reportWarning(
+					context,
+					context.FormatMessage("SkipIncludeConflict", skipIf, includeIf),
+					[]ast.Node{skip, include},
+				)
+			}
+			return visitor.ActionNoChange, nil
+		}
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field:          {Kind: checkDirectives},
+				kinds.FragmentSpread: {Kind: checkDirectives},
+				kinds.InlineFragment: {Kind: checkDirectives},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// constantIfArgument Returns the value of the "if" argument of the directive
+// when it is given as a boolean literal.
+func constantIfArgument(directive *ast.Directive) (bool, bool) {
+	for _, arg := range directive.Arguments {
+		if arg == nil || arg.Name == nil || arg.Name.Value != "if" {
+			continue
+		}
+		if value, ok := arg.Value.(*ast.BooleanValue); ok && value != nil {
+			return value.Value, true
+		}
+	}
+	return false, false
+}
+
+// StreamDirectiveOnListFieldRule Stream directive on list field
+//
+// A GraphQL document is only valid if @stream is only applied to fields of a
+// list type, as only their items can be streamed. It only applies to schemas
+// using the built-in StreamDirective, see IncrementalDeliveryDirectives, not
+// to schemas defining their own @stream.
+func StreamDirectiveOnListFieldRule(context *ValidationContext) *ValidationRuleInstance {
+	streamDefined := context.Schema() != nil && context.Schema().Directive(StreamDirective.Name) == StreamDirective
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.Field: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.Field)
+					if !ok || node == nil || !streamDefined {
+						return visitor.ActionNoChange, nil
+					}
+					fieldDef := context.FieldDef()
+					parentType := context.ParentType()
+					if fieldDef == nil || parentType == nil || reflect.ValueOf(parentType).IsNil() {
+						return visitor.ActionNoChange, nil
+					}
+					if _, ok := GetNullable(fieldDef.Type).(*List); ok {
+						return visitor.ActionNoChange, nil
+					}
+					for _, directive := range node.Directives {
+						if directive != nil && directive.Name != nil && directive.Name.Value == StreamDirective.Name {
+							reportError(
+								context,
+								context.FormatMessage("StreamDirectiveOnListField", parentType.Name(), fieldDef.Name),
+								[]ast.Node{directive},
+							)
+						}
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// StreamInitialCountRule Stream initial count
+//
+// A GraphQL document is only valid if the initialCount argument of each
+// @stream is not negative. Variables are left to the execution. It only
+// applies to schemas using the built-in StreamDirective, see
+// IncrementalDeliveryDirectives, not to schemas defining their own @stream.
+func StreamInitialCountRule(context *ValidationContext) *ValidationRuleInstance {
+	streamDefined := context.Schema() != nil && context.Schema().Directive(StreamDirective.Name) == StreamDirective
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.Directive: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.Directive)
+					if !ok || node == nil || node.Name == nil || node.Name.Value != StreamDirective.Name || !streamDefined {
+						return visitor.ActionSkip, nil
+					}
+					for _, argAST := range node.Arguments {
+						if argAST == nil || argAST.Name == nil || argAST.Name.Value != "initialCount" {
+							continue
+						}
+						valueAST, ok := argAST.Value.(*ast.IntValue)
+						if !ok || valueAST == nil {
+							continue
+						}
+						if initialCount, err := strconv.Atoi(valueAST.Value); err == nil && initialCount < 0 {
+							reportError(
+								context,
+								context.FormatMessage("StreamInitialCount"),
+								[]ast.Node{valueAST},
+							)
+						}
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// SubscriptionRootFieldUnconditionalRule Subscription root field unconditional
+//
+// A GraphQL subscription is only valid if its root field is selected
+// unconditionally, that is without a @skip or @include directive, neither on
+// the field nor on the fragments selecting it. Nested fields may still use
+// the directives.
+func SubscriptionRootFieldUnconditionalRule(context *ValidationContext) *ValidationRuleInstance {
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.OperationDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.OperationDefinition)
+					if !ok || node == nil || node.Operation != ast.OperationTypeSubscription || node.SelectionSet == nil {
+						return visitor.ActionNoChange, nil
+					}
+					checkDirectives := func(directives []*ast.Directive) {
+						for _, directive := range directives {
+							if directive == nil || directive.Name == nil {
+								continue
+							}
+							if name := directive.Name.Value; name == SkipDirective.Name || name == IncludeDirective.Name {
+								reportError(
+									context,
+									operationMessage(context, "SubscriptionRootFieldUnconditional", node, name),
+									[]ast.Node{directive},
 								)
 							}
 						}
 					}
+					// Walk the root selection set, through the fragments it
+					// spreads, down to the root fields.
+					visitedFragments := map[string]bool{}
+					var checkRootSelections func(selectionSet *ast.SelectionSet)
+					checkRootSelections = func(selectionSet *ast.SelectionSet) {
+						if selectionSet == nil {
+							return
+						}
+						for _, selection := range selectionSet.Selections {
+							switch selection := selection.(type) {
+							case *ast.Field:
+								checkDirectives(selection.Directives)
+							case *ast.InlineFragment:
+								checkDirectives(selection.Directives)
+								checkRootSelections(selection.SelectionSet)
+							case *ast.FragmentSpread:
+								checkDirectives(selection.Directives)
+								if selection.Name == nil || visitedFragments[selection.Name.Value] {
+									continue
+								}
+								visitedFragments[selection.Name.Value] = true
+								if fragment := context.Fragment(selection.Name.Value); fragment != nil {
+									checkRootSelections(fragment.SelectionSet)
+								}
+							}
+						}
+					}
+					checkRootSelections(node.SelectionSet)
 					return visitor.ActionNoChange, nil
 				},
 			},
@@ -1412,7 +3324,7 @@ func UniqueArgumentNamesRule(context *ValidationContext) *ValidationRuleInstance
 						if nameAST, ok := knownArgNames[argName]; ok {
 							reportError(
 								context,
-								fmt.Sprintf(`There can be only one argument named "%v".`, argName),
+								context.FormatMessage("UniqueArgumentNames", argName),
 								[]ast.Node{nameAST, node.Name},
 							)
 						} else {
@@ -1429,6 +3341,217 @@ func UniqueArgumentNamesRule(context *ValidationContext) *ValidationRuleInstance
 	}
 }
 
+// UniqueDirectivesPerLocationRule Unique directive names per location
+//
+// A GraphQL document is only valid if all non-repeatable directives at a given
+// location are uniquely named.
+func UniqueDirectivesPerLocationRule(context *ValidationContext) *ValidationRuleInstance {
+	var location ast.Node
+	knownDirectives := map[string]*ast.Directive{}
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.Directive: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.Directive)
+					if !ok || node.Name == nil || len(p.Ancestors) == 0 {
+						return visitor.ActionSkip, nil
+					}
+					// The directives of a location are visited one after the
+					// other, so only those of the current one need be known.
+					if appliedTo := p.Ancestors[len(p.Ancestors)-1]; appliedTo != location {
+						location = appliedTo
+						knownDirectives = map[string]*ast.Directive{}
+					}
+					directiveName := node.Name.Value
+					for _, def := range context.Schema().Directives() {
+						if def.Name == directiveName && def.IsRepeatable {
+							return visitor.ActionSkip, nil
+						}
+					}
+					if known, ok := knownDirectives[directiveName]; ok {
+						reportError(
+							context,
+							context.FormatMessage("UniqueDirectivesPerLocation", directiveName),
+							[]ast.Node{known, node},
+						)
+					} else {
+						knownDirectives[directiveName] = node
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// UniqueDirectiveNamesRule Unique directive names
+//
+// A type system document is only valid if all defined directives have unique
+// names, which includes the directives of the schema it extends, or else the
+// specified directives.
+func UniqueDirectiveNamesRule(context *ValidationContext) *ValidationRuleInstance {
+	knownDirectiveNames := make(map[string]*ast.Name)
+	existingDirectives := SpecifiedDirectives
+	if schema := context.Schema(); schema != nil {
+		existingDirectives = schema.Directives()
+	}
+	existingDirectiveNames := map[string]bool{}
+	for _, directive := range existingDirectives {
+		if directive != nil {
+			existingDirectiveNames[directive.Name] = true
+		}
+	}
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.DirectiveDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.DirectiveDefinition)
+					if !ok || node == nil || node.Name == nil {
+						return visitor.ActionSkip, nil
+					}
+					directiveName := node.Name.Value
+					if existingDirectiveNames[directiveName] {
+						reportError(
+							context,
+							context.FormatMessage("UniqueDirectiveNames.Existing", directiveName),
+							[]ast.Node{node.Name},
+						)
+					} else if nameAST, ok := knownDirectiveNames[directiveName]; ok {
+						reportError(
+							context,
+							context.FormatMessage("UniqueDirectiveNames", directiveName),
+							[]ast.Node{nameAST, node.Name},
+						)
+					} else {
+						knownDirectiveNames[directiveName] = node.Name
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// UniqueFieldDefinitionNamesRule Unique field definition names
+//
+// A type system document is only valid if the fields of each object, interface
+// and input object type have unique names, across the definition of the type
+// and its extensions, and, when the document extends a schema, with the fields
+// the type already has in that schema.
+func UniqueFieldDefinitionNamesRule(context *ValidationContext) *ValidationRuleInstance {
+	knownFieldNames := map[string]map[string]*ast.Name{}
+
+	hasExistingField := func(typeName string, fieldName string) bool {
+		schema := context.Schema()
+		if schema == nil {
+			return false
+		}
+		switch ttype := schema.Type(typeName).(type) {
+		case *Object:
+			_, ok := ttype.Fields()[fieldName]
+			return ok
+		case *Interface:
+			_, ok := ttype.Fields()[fieldName]
+			return ok
+		case *InputObject:
+			_, ok := ttype.Fields()[fieldName]
+			return ok
+		}
+		return false
+	}
+
+	checkFieldNames := func(typeNameAST *ast.Name, fieldNameASTs []*ast.Name) {
+		if typeNameAST == nil {
+			return
+		}
+		typeName := typeNameAST.Value
+		if _, ok := knownFieldNames[typeName]; !ok {
+			knownFieldNames[typeName] = map[string]*ast.Name{}
+		}
+		fieldNames := knownFieldNames[typeName]
+		for _, fieldNameAST := range fieldNameASTs {
+			if fieldNameAST == nil {
+				continue
+			}
+			fieldName := fieldNameAST.Value
+			if hasExistingField(typeName, fieldName) {
+				reportError(
+					context,
+					context.FormatMessage("UniqueFieldDefinitionNames.Existing", typeName, fieldName),
+					[]ast.Node{fieldNameAST},
+				)
+			} else if nameAST, ok := fieldNames[fieldName]; ok {
+				reportError(
+					context,
+					context.FormatMessage("UniqueFieldDefinitionNames", typeName, fieldName),
+					[]ast.Node{nameAST, fieldNameAST},
+				)
+			} else {
+				fieldNames[fieldName] = fieldNameAST
+			}
+		}
+	}
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			// Also visited as the definition of a type extension.
+			kinds.ObjectDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					if node, ok := p.Node.(*ast.ObjectDefinition); ok && node != nil {
+						fieldNameASTs := []*ast.Name{}
+						for _, field := range node.Fields {
+							if field != nil {
+								fieldNameASTs = append(fieldNameASTs, field.Name)
+							}
+						}
+						checkFieldNames(node.Name, fieldNameASTs)
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+			kinds.InterfaceDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					if node, ok := p.Node.(*ast.InterfaceDefinition); ok && node != nil {
+						fieldNameASTs := []*ast.Name{}
+						for _, field := range node.Fields {
+							if field != nil {
+								fieldNameASTs = append(fieldNameASTs, field.Name)
+							}
+						}
+						checkFieldNames(node.Name, fieldNameASTs)
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+			kinds.InputObjectDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					if node, ok := p.Node.(*ast.InputObjectDefinition); ok && node != nil {
+						fieldNameASTs := []*ast.Name{}
+						for _, field := range node.Fields {
+							if field != nil {
+								fieldNameASTs = append(fieldNameASTs, field.Name)
+							}
+						}
+						checkFieldNames(node.Name, fieldNameASTs)
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
 // UniqueFragmentNamesRule Unique fragment names
 //
 // A GraphQL document is only valid if all defined fragments have unique names.
@@ -1452,7 +3575,7 @@ func UniqueFragmentNamesRule(context *ValidationContext) *ValidationRuleInstance
 						if nameAST, ok := knownFragmentNames[fragmentName]; ok {
 							reportError(
 								context,
-								fmt.Sprintf(`There can only be one fragment named "%v".`, fragmentName),
+								context.FormatMessage("UniqueFragmentNames", fragmentName),
 								[]ast.Node{nameAST, node.Name},
 							)
 						} else {
@@ -1501,7 +3624,7 @@ func UniqueInputFieldNamesRule(context *ValidationContext) *ValidationRuleInstan
 						if knownNameAST, ok := knownNames[fieldName]; ok {
 							reportError(
 								context,
-								fmt.Sprintf(`There can be only one input field named "%v".`, fieldName),
+								context.FormatMessage("UniqueInputFieldNames", fieldName),
 								[]ast.Node{knownNameAST, node.Name},
 							)
 						} else {
@@ -1509,7 +3632,7 @@ func UniqueInputFieldNamesRule(context *ValidationContext) *ValidationRuleInstan
 						}
 
 					}
-					return visitor.ActionSkip, nil
+					return visitor.ActionNoChange, nil
 				},
 			},
 		},
@@ -1519,6 +3642,149 @@ func UniqueInputFieldNamesRule(context *ValidationContext) *ValidationRuleInstan
 	}
 }
 
+// NewMaxFragmentsRule Max fragments
+//
+// A GraphQL document is only valid if it defines at most max fragments, which
+// bounds the work of computing which fragments each operation reaches. A
+// negative max is treated as 0.
+func NewMaxFragmentsRule(max int) ValidationRuleFn {
+	if max < 0 {
+		max = 0
+	}
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Document: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						node, ok := p.Node.(*ast.Document)
+						if !ok || node == nil {
+							return visitor.ActionSkip, nil
+						}
+						fragments := []ast.Node{}
+						for _, definition := range node.Definitions {
+							if fragment, ok := definition.(*ast.FragmentDefinition); ok && fragment != nil {
+								fragments = append(fragments, fragment)
+							}
+						}
+						if len(fragments) > max {
+							// Locate the error at the first fragment past the limit.
+							reportError(
+								context,
+								context.FormatMessage("MaxFragments", len(fragments), max),
+								[]ast.Node{fragments[max]},
+							)
+						}
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// NewMaxInputDepthRule Max input depth
+//
+// A GraphQL document is only valid if no input object literal is nested more
+// than max levels deep, including objects nested inside list values. This
+// bounds the work needed to coerce argument and default values.
+func NewMaxInputDepthRule(max int) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		depth := 0
+
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.ObjectValue: {
+					Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if node, ok := p.Node.(*ast.ObjectValue); ok && node != nil {
+							if depth+1 > max {
+								reportError(
+									context,
+									context.FormatMessage("MaxInputDepth", max),
+									[]ast.Node{node},
+								)
+								// Skipping also skips the matching Leave, so depth stays balanced.
+								return visitor.ActionSkip, nil
+							}
+							depth++
+						}
+						return visitor.ActionNoChange, nil
+					},
+					Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+						depth--
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
+// NewMaxRootFieldsRule Max root fields
+//
+// A GraphQL document is only valid if none of its operations selects more
+// than max root fields, including the fields selected through fragments at
+// the root, which caps how many fields a single request can batch.
+func NewMaxRootFieldsRule(max int) ValidationRuleFn {
+	return func(context *ValidationContext) *ValidationRuleInstance {
+		var countFields func(selectionSet *ast.SelectionSet, visitedFragments map[string]bool) int
+		countFields = func(selectionSet *ast.SelectionSet, visitedFragments map[string]bool) int {
+			if selectionSet == nil {
+				return 0
+			}
+			count := 0
+			for _, selection := range selectionSet.Selections {
+				switch selection := selection.(type) {
+				case *ast.Field:
+					count++
+				case *ast.InlineFragment:
+					count += countFields(selection.SelectionSet, visitedFragments)
+				case *ast.FragmentSpread:
+					if selection.Name == nil || visitedFragments[selection.Name.Value] {
+						continue
+					}
+					visitedFragments[selection.Name.Value] = true
+					if fragment := context.Fragment(selection.Name.Value); fragment != nil {
+						count += countFields(fragment.SelectionSet, visitedFragments)
+					}
+				}
+			}
+			return count
+		}
+		visitorOpts := &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.OperationDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						if node, ok := p.Node.(*ast.OperationDefinition); ok && node != nil {
+							if count := countFields(node.SelectionSet, map[string]bool{}); count > max {
+								reportError(
+									context,
+									operationMessage(context, "MaxRootFields", node, count, max),
+									[]ast.Node{node},
+								)
+							}
+						}
+						return visitor.ActionSkip, nil
+					},
+				},
+				kinds.FragmentDefinition: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						return visitor.ActionSkip, nil
+					},
+				},
+			},
+		}
+		return &ValidationRuleInstance{
+			VisitorOpts: visitorOpts,
+		}
+	}
+}
+
 // UniqueOperationNamesRule Unique operation names
 //
 // A GraphQL document is only valid if all defined operations have unique names.
@@ -1541,7 +3807,7 @@ func UniqueOperationNamesRule(context *ValidationContext) *ValidationRuleInstanc
 						if nameAST, ok := knownOperationNames[operationName]; ok {
 							reportError(
 								context,
-								fmt.Sprintf(`There can only be one operation named "%v".`, operationName),
+								context.FormatMessage("UniqueOperationNames", operationName),
 								[]ast.Node{nameAST, errNode},
 							)
 						} else {
@@ -1563,6 +3829,91 @@ func UniqueOperationNamesRule(context *ValidationContext) *ValidationRuleInstanc
 	}
 }
 
+// builtInTypes Returns the types every schema defines: the specified scalars
+// and the introspection types.
+func builtInTypes() []Type {
+	return []Type{
+		Int, Float, String, Boolean, ID,
+		SchemaType, DirectiveType, TypeType, FieldType, InputValueType, EnumValueType,
+		TypeKindEnumType, DirectiveLocationEnumType,
+	}
+}
+
+// UniqueTypeNamesRule Unique type names
+//
+// A type system document is only valid if all defined types have unique names,
+// which includes the built-in types and, when the document extends a schema,
+// the types already in that schema.
+func UniqueTypeNamesRule(context *ValidationContext) *ValidationRuleInstance {
+	knownTypeNames := make(map[string]*ast.Name)
+	existingTypeNames := map[string]bool{}
+	if schema := context.Schema(); schema != nil {
+		for typeName := range schema.TypeMap() {
+			existingTypeNames[typeName] = true
+		}
+	}
+	for _, ttype := range builtInTypes() {
+		existingTypeNames[ttype.Name()] = true
+	}
+
+	checkTypeName := func(p visitor.VisitFuncParams) (string, interface{}) {
+		var nameAST *ast.Name
+		switch node := p.Node.(type) {
+		case *ast.ScalarDefinition:
+			nameAST = node.Name
+		case *ast.ObjectDefinition:
+			nameAST = node.Name
+		case *ast.InterfaceDefinition:
+			nameAST = node.Name
+		case *ast.UnionDefinition:
+			nameAST = node.Name
+		case *ast.EnumDefinition:
+			nameAST = node.Name
+		case *ast.InputObjectDefinition:
+			nameAST = node.Name
+		}
+		if nameAST == nil {
+			return visitor.ActionSkip, nil
+		}
+		if existingTypeNames[nameAST.Value] {
+			reportError(
+				context,
+				context.FormatMessage("UniqueTypeNames.Existing", nameAST.Value),
+				[]ast.Node{nameAST},
+			)
+		} else if knownNameAST, ok := knownTypeNames[nameAST.Value]; ok {
+			reportError(
+				context,
+				context.FormatMessage("UniqueTypeNames", nameAST.Value),
+				[]ast.Node{knownNameAST, nameAST},
+			)
+		} else {
+			knownTypeNames[nameAST.Value] = nameAST
+		}
+		return visitor.ActionSkip, nil
+	}
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.ScalarDefinition:      {Kind: checkTypeName},
+			kinds.ObjectDefinition:      {Kind: checkTypeName},
+			kinds.InterfaceDefinition:   {Kind: checkTypeName},
+			kinds.UnionDefinition:       {Kind: checkTypeName},
+			kinds.EnumDefinition:        {Kind: checkTypeName},
+			kinds.InputObjectDefinition: {Kind: checkTypeName},
+			// Extensions add to a type rather than defining it again.
+			kinds.TypeExtensionDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
 // UniqueVariableNamesRule Unique variable names
 //
 // A GraphQL operation is only valid if all its variables are uniquely named.
@@ -1591,7 +3942,7 @@ func UniqueVariableNamesRule(context *ValidationContext) *ValidationRuleInstance
 						if nameAST, ok := knownVariableNames[variableName]; ok {
 							reportError(
 								context,
-								fmt.Sprintf(`There can only be one variable named "%v".`, variableName),
+								context.FormatMessage("UniqueVariableNames", variableName),
 								[]ast.Node{nameAST, variableNameAST},
 							)
 						} else {
@@ -1608,6 +3959,76 @@ func UniqueVariableNamesRule(context *ValidationContext) *ValidationRuleInstance
 	}
 }
 
+// ValidSchemaDefinitionRule Valid schema definition
+//
+// A type system document is only valid if the root operation types of its
+// schema definition and schema extensions are object types, and each root
+// operation type is defined only once, including by the schema it extends.
+func ValidSchemaDefinitionRule(context *ValidationContext) *ValidationRuleInstance {
+	isObjectType, _ := sdlKnownTypes(context)
+	definedOperations := map[string]bool{}
+	if schema := context.Schema(); schema != nil {
+		definedOperations[ast.OperationTypeQuery] = schema.QueryType() != nil
+		definedOperations[ast.OperationTypeMutation] = schema.MutationType() != nil
+		definedOperations[ast.OperationTypeSubscription] = schema.SubscriptionType() != nil
+	}
+	existingOperations := map[string]bool{}
+	for operation, defined := range definedOperations {
+		existingOperations[operation] = defined
+	}
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			// Also visited as the definition of a schema extension.
+			kinds.SchemaDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					node, ok := p.Node.(*ast.SchemaDefinition)
+					if !ok || node == nil {
+						return visitor.ActionSkip, nil
+					}
+					for _, operationType := range node.OperationTypes {
+						if operationType == nil {
+							continue
+						}
+						operation := operationType.Operation
+						if existingOperations[operation] {
+							reportError(
+								context,
+								context.FormatMessage("ValidSchemaDefinition.Existing", operation),
+								[]ast.Node{operationType},
+							)
+						} else if definedOperations[operation] {
+							reportError(
+								context,
+								context.FormatMessage("ValidSchemaDefinition.Duplicate", operation),
+								[]ast.Node{operationType},
+							)
+						}
+						definedOperations[operation] = true
+
+						if operationType.Type == nil || operationType.Type.Name == nil {
+							continue
+						}
+						typeName := operationType.Type.Name.Value
+						if isObject, ok := isObjectType[typeName]; ok && !isObject {
+							reportError(
+								context,
+								context.FormatMessage("ValidSchemaDefinition.NonObject",
+									strings.ToUpper(operation[:1])+operation[1:], typeName),
+								[]ast.Node{operationType.Type},
+							)
+						}
+					}
+					return visitor.ActionSkip, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
 // VariablesAreInputTypesRule Variables are input types
 //
 // A GraphQL operation is only valid if all the variables it defines are of
@@ -1619,7 +4040,7 @@ func VariablesAreInputTypesRule(context *ValidationContext) *ValidationRuleInsta
 			kinds.VariableDefinition: {
 				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
 					if node, ok := p.Node.(*ast.VariableDefinition); ok && node != nil {
-						ttype, _ := typeFromAST(*context.Schema(), node.Type)
+						ttype, _ := context.typeFromAST(node.Type)
 
 						// If the variable type is not an input type, return an error.
 						if ttype != nil && !IsInputType(ttype) {
@@ -1629,7 +4050,7 @@ func VariablesAreInputTypesRule(context *ValidationContext) *ValidationRuleInsta
 							}
 							reportError(
 								context,
-								fmt.Sprintf(`Variable "$%v" cannot be non-input type "%v".`,
+								context.FormatMessage("VariablesAreInputTypes",
 									variableName, printer.Print(node.Type)),
 								[]ast.Node{node.Type},
 							)
@@ -1645,9 +4066,15 @@ func VariablesAreInputTypesRule(context *ValidationContext) *ValidationRuleInsta
 	}
 }
 
-// If a variable definition has a default value, it's effectively non-null.
+// EffectiveType returns the type a variable of the given definition has in
+// the operation: if the definition has a default value, the variable is
+// effectively non-null.
+func EffectiveType(varType Type, varDef *ast.VariableDefinition) Type {
+	return effectiveType(varType, varDef)
+}
+
 func effectiveType(varType Type, varDef *ast.VariableDefinition) Type {
-	if varDef.DefaultValue == nil {
+	if varDef == nil || varDef.DefaultValue == nil {
 		return varType
 	}
 	if _, ok := varType.(*NonNull); ok {
@@ -1656,6 +4083,43 @@ func effectiveType(varType Type, varDef *ast.VariableDefinition) Type {
 	return NewNonNull(varType)
 }
 
+// RequiredVar A variable an operation defines, as needed to build a typed
+// client for the operation.
+type RequiredVar struct {
+	Name string
+	// Type is the declared type of the variable, nil if it is unknown to
+	// the schema or if the validation runs without a schema.
+	Type Type
+	// Required is whether the variable must be provided: it is non-null and
+	// has no default value.
+	Required bool
+}
+
+// RequiredVariables Returns the variables the operation defines, in the
+// order of their definitions, with their types and whether they are required.
+func RequiredVariables(context *ValidationContext, op *ast.OperationDefinition) []RequiredVar {
+	vars := []RequiredVar{}
+	if op == nil {
+		return vars
+	}
+	for _, varDef := range op.VariableDefinitions {
+		if varDef == nil || varDef.Variable == nil || varDef.Variable.Name == nil {
+			continue
+		}
+		var ttype Type
+		if context.Schema() != nil {
+			ttype, _ = context.typeFromAST(varDef.Type)
+		}
+		_, nonNull := varDef.Type.(*ast.NonNull)
+		vars = append(vars, RequiredVar{
+			Name:     varDef.Variable.Name.Value,
+			Type:     ttype,
+			Required: nonNull && varDef.DefaultValue == nil,
+		})
+	}
+	return vars
+}
+
 // VariablesInAllowedPositionRule Variables passed to field arguments conform to type
 func VariablesInAllowedPositionRule(context *ValidationContext) *ValidationRuleInstance {
 
@@ -1679,15 +4143,14 @@ func VariablesInAllowedPositionRule(context *ValidationContext) *ValidationRuleI
 							}
 							varDef, _ := varDefMap[varName]
 							if varDef != nil && usage.Type != nil {
-								varType, err := typeFromAST(*context.Schema(), varDef.Type)
+								varType, err := context.typeFromAST(varDef.Type)
 								if err != nil {
 									varType = nil
 								}
 								if varType != nil && !isTypeSubTypeOf(context.Schema(), effectiveType(varType, varDef), usage.Type) {
 									reportError(
 										context,
-										fmt.Sprintf(`Variable "$%v" of type "%v" used in position `+
-											`expecting type "%v".`, varName, varType, usage.Type),
+										context.FormatMessage("VariablesInAllowedPosition", varName, varType, usage.Type),
 										[]ast.Node{varDef, usage.Node},
 									)
 								}
@@ -1725,6 +4188,12 @@ func VariablesInAllowedPositionRule(context *ValidationContext) *ValidationRuleI
 // Note that this only validates literal values, variables are assumed to
 // provide values of the correct type.
 func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
+	return isValidLiteralValueWithPrinter(ttype, valueAST, printLiteral)
+}
+
+// isValidLiteralValueWithPrinter Like isValidLiteralValue, but prints the
+// values in the messages with the given printer.
+func isValidLiteralValueWithPrinter(ttype Input, valueAST ast.Value, printValue func(value ast.Value) string) (bool, []string) {
 	if _, ok := ttype.(*NonNull); !ok {
 		if valueAST == nil {
 			return true, nil
@@ -1743,27 +4212,31 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 			return false, []string{e.Error()}
 		}
 		if valueAST == nil {
-			if ttype.OfType.Name() != "" {
-				return false, []string{fmt.Sprintf(`Expected "%v!", found null.`, ttype.OfType.Name())}
-			}
-			return false, []string{"Expected non-null value, found null."}
+			return false, []string{fmt.Sprintf(`Expected "%v", found null.`, ttype)}
 		}
 		ofType, _ := ttype.OfType.(Input)
-		return isValidLiteralValue(ofType, valueAST)
+		return isValidLiteralValueWithPrinter(ofType, valueAST, printValue)
 	case *List:
 		// Lists accept a non-list value as a list of one.
 		itemType, _ := ttype.OfType.(Input)
 		if valueAST, ok := valueAST.(*ast.ListValue); ok {
 			messagesReduce := []string{}
-			for _, value := range valueAST.Values {
-				_, messages := isValidLiteralValue(itemType, value)
-				for idx, message := range messages {
-					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, idx+1, message))
+			for index, value := range valueAST.Values {
+				_, messages := isValidLiteralValueWithPrinter(itemType, value, printValue)
+				for _, message := range messages {
+					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, index+1, message))
 				}
 			}
 			return (len(messagesReduce) == 0), messagesReduce
 		}
-		return isValidLiteralValue(itemType, valueAST)
+		// An object literal can only be a list of one when the list holds
+		// input objects, otherwise point out the missing list.
+		if _, ok := valueAST.(*ast.ObjectValue); ok {
+			if _, ok := GetNamed(itemType).(*InputObject); !ok {
+				return false, []string{fmt.Sprintf(`Expected list of "%v", found object.`, ttype.OfType)}
+			}
+		}
+		return isValidLiteralValueWithPrinter(itemType, valueAST, printValue)
 	case *InputObject:
 		// Input objects check each defined field and look for undefined fields.
 		valueAST, ok := valueAST.(*ast.ObjectValue)
@@ -1789,7 +4262,7 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 			if fieldAST := fieldASTMap[fieldName]; fieldAST != nil {
 				fieldASTValue = fieldAST.Value
 			}
-			if isValid, messages := isValidLiteralValue(field.Type, fieldASTValue); !isValid {
+			if isValid, messages := isValidLiteralValueWithPrinter(field.Type, fieldASTValue, printValue); !isValid {
 				for _, message := range messages {
 					messagesReduce = append(messagesReduce, fmt.Sprintf("In field \"%v\": %v", fieldName, message))
 				}
@@ -1797,12 +4270,41 @@ func isValidLiteralValue(ttype Input, valueAST ast.Value) (bool, []string) {
 		}
 		return (len(messagesReduce) == 0), messagesReduce
 	case *Scalar:
+		if err := ttype.ValidateLiteral(valueAST); err != nil {
+			return false, []string{fmt.Sprintf(`Expected type "%v", found %v; %v`, ttype.Name(), printValue(valueAST), err)}
+		}
 		if isNullish(ttype.ParseLiteral(valueAST)) {
-			return false, []string{fmt.Sprintf(`Expected type "%v", found %v.`, ttype.Name(), printer.Print(valueAST))}
+			return false, []string{fmt.Sprintf(`Expected type "%v", found %v.`, ttype.Name(), printValue(valueAST))}
 		}
 	case *Enum:
+		// Quoting an enum value is a common mistake, point at the right literal.
+		if valueAST, ok := valueAST.(*ast.StringValue); ok {
+			message := fmt.Sprintf(`Enum "%v" cannot represent non-enum value: %v.`, ttype.Name(), printValue(valueAST))
+			for _, value := range ttype.Values() {
+				if value.Name == valueAST.Value {
+					message = fmt.Sprintf(`%v Did you mean the enum value "%v"?`, message, value.Name)
+					break
+				}
+			}
+			return false, []string{message}
+		}
+		// So is giving a number or a boolean, e.g. the value behind the enum,
+		// point at the enum values.
+		switch valueAST.(type) {
+		case *ast.IntValue, *ast.FloatValue, *ast.BooleanValue:
+			valueNames := []string{}
+			for _, value := range ttype.Values() {
+				valueNames = append(valueNames, value.Name)
+			}
+			sort.Strings(valueNames)
+			message := fmt.Sprintf(`Enum "%v" cannot represent non-enum value: %v.`, ttype.Name(), printValue(valueAST))
+			if len(valueNames) > 0 {
+				message = fmt.Sprintf(`%v Did you mean the enum value %v?`, message, quotedOrList(valueNames))
+			}
+			return false, []string{message}
+		}
 		if isNullish(ttype.ParseLiteral(valueAST)) {
-			return false, []string{fmt.Sprintf(`Expected type "%v", found %v.`, ttype.Name(), printer.Print(valueAST))}
+			return false, []string{fmt.Sprintf(`Expected type "%v", found %v.`, ttype.Name(), printValue(valueAST))}
 		}
 	}
 
@@ -1820,6 +4322,7 @@ func (s suggestionListResult) Len() int {
 }
 func (s suggestionListResult) Swap(i, j int) {
 	s.Options[i], s.Options[j] = s.Options[j], s.Options[i]
+	s.Distances[i], s.Distances[j] = s.Distances[j], s.Distances[i]
 }
 func (s suggestionListResult) Less(i, j int) bool {
 	return s.Distances[i] < s.Distances[j]
@@ -1830,11 +4333,11 @@ func (s suggestionListResult) Less(i, j int) bool {
 func suggestionList(input string, options []string) []string {
 	dists := []float64{}
 	filteredOpts := []string{}
-	inputThreshold := float64(len(input) / 2)
+	inputThreshold := float64(utf8.RuneCountInString(input) / 2)
 
 	for _, opt := range options {
-		dist := lexicalDistance(input, opt)
-		threshold := math.Max(inputThreshold, float64(len(opt)/2))
+		dist := LexicalDistance(input, opt)
+		threshold := math.Max(inputThreshold, float64(utf8.RuneCountInString(opt)/2))
 		threshold = math.Max(threshold, 1)
 		if dist <= threshold {
 			filteredOpts = append(filteredOpts, opt)
@@ -1847,13 +4350,17 @@ func suggestionList(input string, options []string) []string {
 	return suggested.Options
 }
 
-// lexicalDistance Computes the lexical distance between strings A and B.
+// LexicalDistance Computes the lexical distance between strings A and B.
 // The "distance" between two strings is given by counting the minimum number
 // of edits needed to transform string A into string B. An edit can be an
 // insertion, deletion, or substitution of a single character, or a swap of two
 // adjacent characters.
 // This distance can be useful for detecting typos in input or sorting
-func lexicalDistance(a, b string) float64 {
+//
+// Characters are compared as runes, so a multibyte character counts as one.
+func LexicalDistance(aStr, bStr string) float64 {
+	a := []rune(aStr)
+	b := []rune(bStr)
 	d := [][]float64{}
 	aLen := len(a)
 	bLen := len(b)
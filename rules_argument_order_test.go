@@ -0,0 +1,57 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+// multipleOptAndReqOrder The order in which multipleOptAndReq declares its
+// arguments.
+var multipleOptAndReqOrder = map[string][]string{
+	"ComplicatedArgs.multipleOptAndReq": {"req1", "req2", "opt1", "opt2"},
+}
+
+func TestValidate_ArgumentOrder_InOrder(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewArgumentOrderRule(multipleOptAndReqOrder), `
+      {
+        complicatedArgs {
+          multipleOptAndReq(req1: 1, req2: 2, opt2: 2)
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
+
+func TestValidate_ArgumentOrder_OutOfOrder(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewArgumentOrderRule(multipleOptAndReqOrder), `
+      {
+        complicatedArgs {
+          multipleOptAndReq(opt1: 1, req2: 2, req1: 1)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Argument "req2" of field "ComplicatedArgs.multipleOptAndReq" should be given before argument "opt1".`, 4, 38),
+	})
+}
+
+func TestValidate_ArgumentOrder_IgnoresUnknownArguments(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewArgumentOrderRule(multipleOptAndReqOrder), `
+      {
+        complicatedArgs {
+          multipleOptAndReq(unknown: 1, req1: 1, other: 2, req2: 2)
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
+
+func TestValidate_ArgumentOrder_IgnoresFieldsWithoutOrder(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewArgumentOrderRule(multipleOptAndReqOrder), `
+      {
+        complicatedArgs {
+          multipleReqs(req2: 2, req1: 1)
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
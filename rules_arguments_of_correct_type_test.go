@@ -1,10 +1,17 @@
 package graphql_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/language/printer"
+	"github.com/graphql-go/graphql/language/visitor"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -53,6 +60,15 @@ func TestValidate_ArgValuesOfCorrectType_ValidValue_IntIntoFloat(t *testing.T) {
         }
     `)
 }
+func TestValidate_ArgValuesOfCorrectType_ValidValue_LargeIntIntoFloat(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          complicatedArgs {
+            floatArgField(floatArg: 16777217)
+          }
+        }
+    `)
+}
 func TestValidate_ArgValuesOfCorrectType_ValidValue_IntIntoID(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.ArgumentsOfCorrectTypeRule, `
         {
@@ -381,7 +397,8 @@ func TestValidate_ArgValuesOfCorrectType_InvalidEnumValue_IntIntoEnum(t *testing
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"dogCommand\" has invalid value 2.\nExpected type \"DogCommand\", found 2.",
+				"Argument \"dogCommand\" has invalid value 2.\nEnum \"DogCommand\" cannot represent non-enum value: 2. "+
+					"Did you mean the enum value \"DOWN\", \"HEEL\", or \"SIT\"?",
 				4, 41,
 			),
 		})
@@ -396,7 +413,8 @@ func TestValidate_ArgValuesOfCorrectType_InvalidEnumValue_FloatIntoEnum(t *testi
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"dogCommand\" has invalid value 1.0.\nExpected type \"DogCommand\", found 1.0.",
+				"Argument \"dogCommand\" has invalid value 1.0.\nEnum \"DogCommand\" cannot represent non-enum value: 1.0. "+
+					"Did you mean the enum value \"DOWN\", \"HEEL\", or \"SIT\"?",
 				4, 41,
 			),
 		})
@@ -411,7 +429,22 @@ func TestValidate_ArgValuesOfCorrectType_InvalidEnumValue_StringIntoEnum(t *test
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"dogCommand\" has invalid value \"SIT\".\nExpected type \"DogCommand\", found \"SIT\".",
+				"Argument \"dogCommand\" has invalid value \"SIT\".\nEnum \"DogCommand\" cannot represent non-enum value: \"SIT\". Did you mean the enum value \"SIT\"?",
+				4, 41,
+			),
+		})
+}
+func TestValidate_ArgValuesOfCorrectType_InvalidEnumValue_UnknownStringIntoEnum(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          dog {
+            doesKnowCommand(dogCommand: "JUGGLE")
+          }
+        }
+        `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				"Argument \"dogCommand\" has invalid value \"JUGGLE\".\nEnum \"DogCommand\" cannot represent non-enum value: \"JUGGLE\".",
 				4, 41,
 			),
 		})
@@ -426,7 +459,8 @@ func TestValidate_ArgValuesOfCorrectType_InvalidEnumValue_BooleanIntoEnum(t *tes
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"dogCommand\" has invalid value true.\nExpected type \"DogCommand\", found true.",
+				"Argument \"dogCommand\" has invalid value true.\nEnum \"DogCommand\" cannot represent non-enum value: true. "+
+					"Did you mean the enum value \"DOWN\", \"HEEL\", or \"SIT\"?",
 				4, 41,
 			),
 		})
@@ -500,7 +534,7 @@ func TestValidate_ArgValuesOfCorrectType_InvalidListValue_IncorrectItemType(t *t
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"stringListArg\" has invalid value [\"one\", 2].\nIn element #1: Expected type \"String\", found 2.",
+				"Argument \"stringListArg\" has invalid value [\"one\", 2].\nIn element #2: Expected type \"String\", found 2.",
 				4, 47,
 			),
 		})
@@ -521,6 +555,22 @@ func TestValidate_ArgValuesOfCorrectType_InvalidListValue_SingleValueOfIncorrent
 		})
 }
 
+func TestValidate_ArgValuesOfCorrectType_InvalidListValue_ObjectIntoList(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          complicatedArgs {
+            stringListArgField(stringListArg: { one: "one" })
+          }
+        }
+        `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				"Argument \"stringListArg\" has invalid value {one: \"one\"}.\nExpected list of \"String\", found object.",
+				4, 47,
+			),
+		})
+}
+
 func TestValidate_ArgValuesOfCorrectType_ValidNonNullableValue_ArgOnOptionalArg(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.ArgumentsOfCorrectTypeRule, `
         {
@@ -742,7 +792,7 @@ func TestValidate_ArgValuesOfCorrectType_InvalidInputObjectValue_PartialObject_I
         `,
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
-				"Argument \"complexArg\" has invalid value {stringListField: [\"one\", 2], requiredField: true}.\nIn field \"stringListField\": In element #1: Expected type \"String\", found 2.",
+				"Argument \"complexArg\" has invalid value {stringListField: [\"one\", 2], requiredField: true}.\nIn field \"stringListField\": In element #2: Expected type \"String\", found 2.",
 				4, 41,
 			),
 		})
@@ -799,3 +849,232 @@ func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_WithDirectivesWithIn
 			),
 		})
 }
+func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_WithNumbersAsConditions(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          dog @skip(if: 5) {
+            name @include(if: 1.5)
+          }
+        }
+        `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				`Argument "if" has invalid value 5.`+
+					"\nExpected type \"Boolean\", found 5.",
+				3, 25,
+			),
+			testutil.RuleError(
+				`Argument "if" has invalid value 1.5.`+
+					"\nExpected type \"Boolean\", found 1.5.",
+				4, 31,
+			),
+		})
+}
+
+func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_WithValidEnumValue(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          dog @cacheControl(scope: PRIVATE) {
+            name
+          }
+        }
+    `)
+}
+
+func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_WithInvalidEnumValue(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          dog @cacheControl(scope: SECRET) {
+            name
+          }
+        }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(
+			"Argument \"scope\" has invalid value SECRET.\nExpected type \"CacheControlScope\", found SECRET.",
+			3, 36,
+		),
+	})
+}
+
+func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_DeprecatedWithStringReason(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        type Dog {
+          nickname: String @deprecated(reason: "old")
+        }
+    `)
+}
+
+func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_DeprecatedWithIntReason(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        type Dog {
+          nickname: String @deprecated(reason: 5)
+        }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(
+			"Argument \"reason\" has invalid value 5.\nExpected type \"String\", found 5.",
+			3, 48,
+		),
+	})
+}
+
+func TestValidate_ArgValuesOfCorrectType_DirectiveArguments_ResolveEnumType(t *testing.T) {
+	var directiveName, argumentName, inputType string
+	rule := func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+		return &graphql.ValidationRuleInstance{
+			VisitorOpts: &visitor.VisitorOptions{
+				KindFuncMap: map[string]visitor.NamedVisitFuncs{
+					kinds.EnumValue: {
+						Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+							if directive := context.Directive(); directive != nil {
+								directiveName = directive.Name
+							}
+							if argument := context.Argument(); argument != nil {
+								argumentName = argument.Name()
+							}
+							if ttype := context.InputType(); ttype != nil {
+								inputType = ttype.String()
+							}
+							return visitor.ActionNoChange, nil
+						},
+					},
+				},
+			},
+		}
+	}
+	testutil.ExpectPassesRule(t, rule, `
+        {
+          dog @cacheControl(scope: PUBLIC) {
+            name
+          }
+        }
+    `)
+	if directiveName != "cacheControl" || argumentName != "scope" || inputType != "CacheControlScope" {
+		t.Fatalf("Unexpected type info, got directive %q, argument %q and input type %q",
+			directiveName, argumentName, inputType)
+	}
+}
+
+func TestValidate_ArgValuesOfCorrectType_ReportsExtensions(t *testing.T) {
+	expected := testutil.RuleError(
+		"Argument \"req2\" has invalid value \"two\".\nExpected type \"Int\", found \"two\".",
+		4, 32,
+	)
+	expected.Extensions = map[string]interface{}{
+		"argumentName": "req2",
+		"expectedType": "Int!",
+		"value":        `"two"`,
+	}
+	testutil.ExpectFailsRule(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          complicatedArgs {
+            multipleReqs(req2: "two", req1: 1)
+          }
+        }
+        `,
+		[]gqlerrors.FormattedError{expected})
+}
+
+func nestedDateInputSchema(t *testing.T) *graphql.Schema {
+	parseDate := func(valueAST ast.Value) (interface{}, error) {
+		value, ok := valueAST.(*ast.StringValue)
+		if !ok {
+			return nil, errors.New("Date must be given as a string")
+		}
+		date, err := time.Parse("2006-01-02", value.Value)
+		if err != nil {
+			return nil, errors.New("Date must be formatted as YYYY-MM-DD")
+		}
+		return date, nil
+	}
+	dateType := graphql.NewScalar(graphql.ScalarConfig{
+		Name: "Date",
+		Serialize: func(value interface{}) interface{} {
+			return value
+		},
+		ParseValue: func(value interface{}) interface{} {
+			return value
+		},
+		ParseLiteral: func(valueAST ast.Value) interface{} {
+			date, _ := parseDate(valueAST)
+			return date
+		},
+		ValidateLiteral: func(valueAST ast.Value) error {
+			_, err := parseDate(valueAST)
+			return err
+		},
+	})
+	rangeType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "DateRange",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"from": &graphql.InputObjectFieldConfig{Type: dateType},
+		},
+	})
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EventFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"range": &graphql.InputObjectFieldConfig{Type: rangeType},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"events": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						"filter": &graphql.ArgumentConfig{Type: filterType},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return &schema
+}
+
+func TestValidate_ArgValuesOfCorrectType_NestedCustomScalar_ValidLiteral(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, nestedDateInputSchema(t), graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          events(filter: {range: {from: "2020-01-31"}})
+        }
+        `)
+}
+func TestValidate_ArgValuesOfCorrectType_NestedCustomScalar_InvalidLiteral(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, nestedDateInputSchema(t), graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          events(filter: {range: {from: "2020-01-32"}})
+        }
+        `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				"Argument \"filter\" has invalid value {range: {from: \"2020-01-32\"}}.\n"+
+					"In field \"range\": In field \"from\": Expected type \"Date\", found \"2020-01-32\"; "+
+					"Date must be formatted as YYYY-MM-DD",
+				3, 26,
+			),
+		})
+}
+
+func TestValidate_ArgValuesOfCorrectType_ValuePrinterMasksStrings(t *testing.T) {
+	maskStrings := func(value ast.Value) string {
+		if _, ok := value.(*ast.StringValue); ok {
+			return `"***"`
+		}
+		return fmt.Sprintf("%v", printer.Print(value))
+	}
+	testutil.ExpectFailsRuleWithOptions(t, graphql.ArgumentsOfCorrectTypeRule, `
+        {
+          complicatedArgs {
+            intArgField(intArg: "secret")
+          }
+        }
+        `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				"Argument \"intArg\" has invalid value \"***\".\nExpected type \"Int\", found \"***\".",
+				4, 33,
+			),
+		}, &graphql.ValidationOptions{ValuePrinter: maskStrings})
+}
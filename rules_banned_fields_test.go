@@ -0,0 +1,50 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+var bannedFields = map[string][]string{
+	"Dog": {"barkVolume", "nickname"},
+}
+
+func TestValidate_BannedFields_AllowedFields(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewBannedFieldsRule(bannedFields), `
+      {
+        dog {
+          name
+        }
+      }
+    `)
+}
+func TestValidate_BannedFields_SameNameOnOtherType(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewBannedFieldsRule(bannedFields), `
+      {
+        cat {
+          nickname
+        }
+      }
+    `)
+}
+func TestValidate_BannedFields_BannedFieldOnType(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewBannedFieldsRule(bannedFields), `
+      {
+        dog {
+          name
+          barkVolume
+        }
+        pet {
+          ... on Dog {
+            loud: barkVolume
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "Dog.barkVolume" is not allowed.`, 5, 11),
+		testutil.RuleError(`Field "Dog.barkVolume" is not allowed.`, 9, 13),
+	})
+}
@@ -52,6 +52,34 @@ func TestValidate_VariableDefaultValuesOfCorrectType_NoRequiredVariablesWithDefa
 			),
 		})
 }
+func TestValidate_VariableDefaultValuesOfCorrectType_RequiredVariableWithDefaultValueReportedOnce(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.DefaultValuesOfCorrectTypeRule, `
+      query UnreachableDefaultValue($x: Int! = 5) {
+        dog { name }
+      }
+    `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				`Variable "$x" of type "Int!" is required and will not `+
+					`use the default value. Perhaps you meant to use type "Int".`,
+				2, 48,
+			),
+		})
+}
+func TestValidate_VariableDefaultValuesOfCorrectType_RequiredVariableWithInvalidDefaultValueReportedOnce(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.DefaultValuesOfCorrectTypeRule, `
+      query UnreachableDefaultValue($x: Int! = "five") {
+        dog { name }
+      }
+    `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				`Variable "$x" of type "Int!" is required and will not `+
+					`use the default value. Perhaps you meant to use type "Int".`,
+				2, 48,
+			),
+		})
+}
 func TestValidate_VariableDefaultValuesOfCorrectType_VariablesWithInvalidDefaultValues(t *testing.T) {
 	testutil.ExpectFailsRule(t, graphql.DefaultValuesOfCorrectTypeRule, `
       query InvalidDefaultValues(
@@ -75,6 +103,30 @@ func TestValidate_VariableDefaultValuesOfCorrectType_VariablesWithInvalidDefault
 				5, 28),
 		})
 }
+func TestValidate_VariableDefaultValuesOfCorrectType_ShortInvalidDefaultValuePrintedInFull(t *testing.T) {
+	testutil.ExpectFailsRuleWithOptions(t, graphql.DefaultValuesOfCorrectTypeRule, `
+      query InvalidDefaultValues($a: Int = "one") {
+        dog { name }
+      }
+    `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(`Variable "$a" has invalid default value: "one".`+
+				"\nExpected type \"Int\", found \"one\".",
+				2, 44),
+		}, &graphql.ValidationOptions{MaxPrintedValueLength: 20})
+}
+func TestValidate_VariableDefaultValuesOfCorrectType_LongInvalidDefaultValueTruncated(t *testing.T) {
+	testutil.ExpectFailsRuleWithOptions(t, graphql.DefaultValuesOfCorrectTypeRule, `
+      query InvalidDefaultValues($a: [String] = ["one", "two", "three", "four", 5]) {
+        dog { name }
+      }
+    `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(`Variable "$a" has invalid default value: ["one", "two", "thre....`+
+				"\nIn element #5: Expected type \"String\", found 5.",
+				2, 49),
+		}, &graphql.ValidationOptions{MaxPrintedValueLength: 20})
+}
 func TestValidate_VariableDefaultValuesOfCorrectType_ComplexVariablesMissingRequiredField(t *testing.T) {
 	testutil.ExpectFailsRule(t, graphql.DefaultValuesOfCorrectTypeRule, `
       query MissingRequiredField($a: ComplexInput = {intField: 3}) {
@@ -97,7 +149,7 @@ func TestValidate_VariableDefaultValuesOfCorrectType_ListVariablesWithInvalidIte
 		[]gqlerrors.FormattedError{
 			testutil.RuleError(
 				`Variable "$a" has invalid default value: ["one", 2].`+
-					"\nIn element #1: Expected type \"String\", found 2.",
+					"\nIn element #2: Expected type \"String\", found 2.",
 				2, 40),
 		})
 }
@@ -105,3 +157,29 @@ func TestValidate_VariableDefaultValuesOfCorrectType_ListVariablesWithInvalidIte
 func TestValidate_VariableDefaultValuesOfCorrectType_InvalidNonNull(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.DefaultValuesOfCorrectTypeRule, `query($g:e!){a}`)
 }
+func TestValidate_VariableDefaultValuesOfCorrectType_ComplexVariablesWithWronglyTypedField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.DefaultValuesOfCorrectTypeRule, `
+      query WrongFieldType($a: ComplexInput = {requiredField: true, intField: "three"}) {
+        dog { name }
+      }
+    `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				`Variable "$a" has invalid default value: {requiredField: true, intField: "three"}.`+
+					"\nIn field \"intField\": Expected type \"Int\", found \"three\".",
+				2, 47),
+		})
+}
+func TestValidate_VariableDefaultValuesOfCorrectType_ComplexVariablesWithWronglyTypedListItemInField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.DefaultValuesOfCorrectTypeRule, `
+      query WrongListItem($a: ComplexInput = {requiredField: true, stringListField: ["one", 2]}) {
+        dog { name }
+      }
+    `,
+		[]gqlerrors.FormattedError{
+			testutil.RuleError(
+				`Variable "$a" has invalid default value: {requiredField: true, stringListField: ["one", 2]}.`+
+					"\nIn field \"stringListField\": In element #2: Expected type \"String\", found 2.",
+				2, 46),
+		})
+}
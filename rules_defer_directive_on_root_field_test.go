@@ -0,0 +1,128 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_DeferDirectiveOnRootField_QueryRoot(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, incrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      {
+        ... @defer {
+          item {
+            name
+          }
+        }
+      }
+    `)
+}
+
+func TestValidate_DeferDirectiveOnRootField_MutationRoot(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, incrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      mutation {
+        ...AddItem @defer
+      }
+      fragment AddItem on Mutation {
+        addItem {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`@defer is not allowed on root fields of mutation.`, 3, 20),
+	})
+}
+
+func TestValidate_DeferDirectiveOnRootField_SubscriptionRoot(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, incrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      subscription {
+        ... @defer {
+          itemAdded {
+            name
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`@defer is not allowed on root fields of subscription.`, 3, 13),
+	})
+}
+
+func TestValidate_DeferDirectiveOnRootField_NestedInMutation(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, incrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      mutation {
+        addItem {
+          ... @defer {
+            name
+          }
+        }
+      }
+    `)
+}
+
+func TestValidate_DeferDirectiveOnRootField_MutationTypeReachedFromQuery(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, incrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      {
+        mutations {
+          ... @defer {
+            addItem {
+              name
+            }
+          }
+        }
+      }
+    `)
+}
+
+func TestValidate_DeferDirectiveOnRootField_MutationRootThroughNestedFragments(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, incrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      mutation {
+        ...Outer
+      }
+      fragment Outer on Mutation {
+        ... {
+          ...AddItem @defer
+        }
+      }
+      fragment AddItem on Mutation {
+        addItem {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`@defer is not allowed on root fields of mutation.`, 7, 22),
+	})
+}
+
+func TestValidate_DeferDirectiveOnRootField_FragmentSpreadByMutationAndSubscription(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, incrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      mutation {
+        ...Root
+        ...Root
+      }
+      subscription {
+        ...Root
+      }
+      fragment Root on Mutation {
+        ... @defer {
+          __typename
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`@defer is not allowed on root fields of mutation.`, 10, 13),
+		testutil.RuleError(`@defer is not allowed on root fields of subscription.`, 10, 13),
+	})
+}
+
+func TestValidate_DeferDirectiveOnRootField_IgnoredWithCustomDefer(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, customIncrementalDeliverySchema(t), graphql.DeferDirectiveOnRootFieldRule, `
+      mutation {
+        ... @defer {
+          addItem {
+            name
+          }
+        }
+      }
+    `)
+}
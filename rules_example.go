@@ -0,0 +1,88 @@
+package graphql
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/language/visitor"
+)
+
+// ExampleFieldVisitorRule Example field visitor
+//
+// A template for custom rules: it reports each selection of a field named
+// "secret". Copy it, rename it and replace the check on the field to write a
+// rule, then pass it to ValidateDocument along with SpecifiedRules.
+func ExampleFieldVisitorRule(context *ValidationContext) *ValidationRuleInstance {
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.Field: {
+				Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+					field, ok := p.Node.(*ast.Field)
+					if !ok || field.Name == nil || field.Name.Value != "secret" {
+						return visitor.ActionNoChange, nil
+					}
+					// context.ParentType() and context.FieldDef() describe the
+					// field in the schema, when known.
+					context.ReportValidationError(
+						fmt.Sprintf(`Field "%v" must not be queried.`, field.Name.Value),
+						[]ast.Node{field},
+					)
+					return visitor.ActionNoChange, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// ExampleRemoveRedundantInlineFragmentRule Example autofix rule
+//
+// A template for rules which fix the document, to run with
+// ValidationOptions.Fix: it replaces each inline fragment on the type of the
+// enclosing selection set, which always applies, with its own selections.
+// Returning visitor.ActionUpdate along with a new node replaces the visited
+// node in ValidationResult.FixedDocument.
+func ExampleRemoveRedundantInlineFragmentRule(context *ValidationContext) *ValidationRuleInstance {
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.SelectionSet: {
+				// Replace the selection set on leave, once its fragments were
+				// fixed themselves.
+				Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+					selectionSet, ok := p.Node.(*ast.SelectionSet)
+					parentType := context.ParentType()
+					if !ok || parentType == nil || reflect.ValueOf(parentType).IsNil() {
+						return visitor.ActionNoChange, nil
+					}
+					selections := []ast.Selection{}
+					changed := false
+					for _, selection := range selectionSet.Selections {
+						fragment, ok := selection.(*ast.InlineFragment)
+						if ok && len(fragment.Directives) == 0 && fragment.SelectionSet != nil &&
+							fragment.TypeCondition != nil && fragment.TypeCondition.Name != nil &&
+							fragment.TypeCondition.Name.Value == parentType.Name() {
+							selections = append(selections, fragment.SelectionSet.Selections...)
+							changed = true
+							continue
+						}
+						selections = append(selections, selection)
+					}
+					if !changed {
+						return visitor.ActionNoChange, nil
+					}
+					return visitor.ActionUpdate, ast.NewSelectionSet(&ast.SelectionSet{
+						Loc:        selectionSet.Loc,
+						Selections: selections,
+					})
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
@@ -0,0 +1,98 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/printer"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_ExampleFieldVisitor_OtherFields(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.ExampleFieldVisitorRule, `
+      {
+        dog {
+          name
+        }
+      }
+    `)
+}
+func TestValidate_ExampleFieldVisitor_ReportsSecretField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ExampleFieldVisitorRule, `
+      {
+        dog {
+          name
+          secret
+        }
+        secret
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "secret" must not be queried.`, 5, 11),
+		testutil.RuleError(`Field "secret" must not be queried.`, 7, 9),
+	})
+}
+func TestValidate_ExampleFieldVisitor_AlongWithSpecifiedRules(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { name } }`)
+	rules := append([]graphql.ValidationRuleFn{graphql.ExampleFieldVisitorRule}, graphql.SpecifiedRules...)
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, rules)
+	if !result.IsValid {
+		t.Fatalf("Expected a valid result, got %v", result.Errors)
+	}
+}
+func TestValidate_ExampleRemoveRedundantInlineFragment_FixesDocument(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          ... on Dog {
+            name
+          }
+          ... on Pet {
+            name
+          }
+          barkVolume
+        }
+      }
+    `)
+	result := graphql.ValidateDocumentWithOptions(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.ExampleRemoveRedundantInlineFragmentRule,
+	}, &graphql.ValidationOptions{
+		Fix: true,
+	})
+	if !result.IsValid {
+		t.Fatalf("Expected a valid result, got %v", result.Errors)
+	}
+	expected := `{
+  dog {
+    name
+    ... on Pet {
+      name
+    }
+    barkVolume
+  }
+}
+`
+	if printed := printer.Print(result.FixedDocument); printed != expected {
+		t.Fatalf("Unexpected fixed document, Diff: %v", testutil.Diff(expected, printed))
+	}
+}
+func TestValidate_ExampleRemoveRedundantInlineFragment_IgnoredWithoutFix(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { ... on Dog { name } } }`)
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.ExampleRemoveRedundantInlineFragmentRule,
+	})
+	if result.FixedDocument != nil {
+		t.Fatalf("Expected no fixed document, got %v", result.FixedDocument)
+	}
+	expected := `{
+  dog {
+    ... on Dog {
+      name
+    }
+  }
+}
+`
+	if printed := printer.Print(doc); printed != expected {
+		t.Fatalf("Expected the document to be left as is, Diff: %v", testutil.Diff(expected, printed))
+	}
+}
@@ -1,6 +1,7 @@
 package graphql_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/graphql-go/graphql"
@@ -145,6 +146,31 @@ func TestValidate_FieldsOnCorrectType_DefinedOnImplementorsButNotOnInterface(t *
 		testutil.RuleError(`Cannot query field "nickname" on type "Pet". Did you mean to use an inline fragment on "Cat" or "Dog"?`, 3, 9),
 	})
 }
+func TestValidate_FieldsOnCorrectType_DefinedOnImplementorsInInlineFragmentWithoutTypeCondition(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.FieldsOnCorrectTypeRule, `
+      fragment conditionlessInlineFragment on Pet {
+        ... {
+          name
+          barkVolume
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot query field "barkVolume" on type "Pet". Did you mean to add a type condition on "Dog" to the inline fragment?`, 5, 11),
+	})
+}
+func TestValidate_FieldsOnCorrectType_DefinedOnImplementorsNestedInInlineFragmentWithoutTypeCondition(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.FieldsOnCorrectTypeRule, `
+      {
+        ... {
+          pet {
+            barkVolume
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot query field "barkVolume" on type "Pet". Did you mean to use an inline fragment on "Dog"?`, 5, 13),
+	})
+}
 func TestValidate_FieldsOnCorrectType_MetaFieldSelectionOnUnion(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.FieldsOnCorrectTypeRule, `
       fragment directFieldSelectionOnUnion on CatOrDog {
@@ -240,3 +266,69 @@ func TestValidate_FieldsOnCorrectTypeErrorMessage_LimitLotsOfFieldSuggestions(t
 func TestValidate_FieldsOnCorrectType_NilCrash(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.FieldsOnCorrectTypeRule, `mutation{o}`)
 }
+
+func TestValidate_FieldsOnCorrectType_AllowedMetaFieldOnQueryRoot(t *testing.T) {
+	testutil.ExpectPassesRuleWithOptions(t, graphql.FieldsOnCorrectTypeRule, `
+      {
+        _service
+      }
+    `, &graphql.ValidationOptions{AllowedMetaFields: []string{"_service"}})
+}
+
+func TestValidate_FieldsOnCorrectType_UnlistedMetaFieldStillReported(t *testing.T) {
+	testutil.ExpectFailsRuleWithOptions(t, graphql.FieldsOnCorrectTypeRule, `
+      {
+        _entities
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot query field "_entities" on type "QueryRoot".`, 3, 9),
+	}, &graphql.ValidationOptions{AllowedMetaFields: []string{"_service"}})
+}
+
+func TestValidate_FieldsOnCorrectType_AllowedMetaFieldOnlyOnQueryRoot(t *testing.T) {
+	testutil.ExpectFailsRuleWithOptions(t, graphql.FieldsOnCorrectTypeRule, `
+      {
+        dog {
+          _service
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot query field "_service" on type "Dog".`, 4, 11),
+	}, &graphql.ValidationOptions{AllowedMetaFields: []string{"_service"}})
+}
+
+func TestValidate_FieldsOnCorrectType_UsesACustomMessageFormatter(t *testing.T) {
+	testutil.ExpectFailsRuleWithOptions(t, graphql.FieldsOnCorrectTypeRule, `
+      fragment fieldNotDefined on Dog {
+        meowVolume
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Le champ "meowVolume" n'existe pas sur le type "Dog". Vouliez-vous dire "barkVolume" ?`, 3, 9),
+	}, &graphql.ValidationOptions{
+		MessageFormatter: graphql.MessageTemplates{
+			"FieldsOnCorrectType": `Le champ "%v" n'existe pas sur le type "%v".`,
+			"DidYouMean":          `%v Vouliez-vous dire %v ?`,
+		},
+	})
+}
+
+type upperCaseMessageFormatter struct{}
+
+func (upperCaseMessageFormatter) Format(key string, args ...interface{}) string {
+	if key == "FieldsOnCorrectType" {
+		return fmt.Sprintf("NO FIELD %v ON %v", args...)
+	}
+	return graphql.DefaultMessageTemplates.Format(key, args...)
+}
+
+func TestValidate_FieldsOnCorrectType_UsesACustomMessageFormatterImplementation(t *testing.T) {
+	testutil.ExpectFailsRuleWithOptions(t, graphql.FieldsOnCorrectTypeRule, `
+      fragment fieldNotDefined on Dog {
+        unknownField
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`NO FIELD unknownField ON Dog`, 3, 9),
+	}, &graphql.ValidationOptions{
+		MessageFormatter: upperCaseMessageFormatter{},
+	})
+}
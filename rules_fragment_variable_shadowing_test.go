@@ -0,0 +1,123 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_FragmentVariableShadowing_SameTypeAcrossOperations(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewFragmentVariableShadowingRule(), `
+      query A($x: Int!) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      query B($x: Int!) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      fragment F on ComplicatedArgs {
+        nonNullIntArgField(nonNullIntArg: $x)
+      }
+    `)
+}
+
+func TestValidate_FragmentVariableShadowing_UnsharedVariableNames(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewFragmentVariableShadowingRule(), `
+      query A($x: Int!) {
+        complicatedArgs {
+          nonNullIntArgField(nonNullIntArg: $x)
+          ...F
+        }
+      }
+      query B($x: String) {
+        complicatedArgs {
+          stringArgField(stringArg: $x)
+          ...F
+        }
+      }
+      fragment F on ComplicatedArgs {
+        intArgField
+      }
+    `)
+}
+
+func TestValidate_FragmentVariableShadowing_IncompatibleTypesAcrossOperations(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewFragmentVariableShadowingRule(), `
+      query A($x: Int!) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      query B($x: String) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      fragment F on ComplicatedArgs {
+        nonNullIntArgField(nonNullIntArg: $x)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$x" used in fragment "F" is defined as "Int!" by operation "A" but as "String" by operation "B".`, 13, 43),
+	})
+}
+
+func TestValidate_FragmentVariableShadowing_NonNullAndNullableInNullablePosition(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewFragmentVariableShadowingRule(), `
+      query A($x: Int!) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      query B($x: Int) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      fragment F on ComplicatedArgs {
+        intArgField(intArg: $x)
+      }
+    `)
+}
+
+func TestValidate_FragmentVariableShadowing_NullableInNonNullPosition(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewFragmentVariableShadowingRule(), `
+      query A($x: Int) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      query B($x: Int!) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      fragment F on ComplicatedArgs {
+        nonNullIntArgField(nonNullIntArg: $x)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$x" used in fragment "F" is defined as "Int!" by operation "B" but as "Int" by operation "A".`, 13, 43),
+	})
+}
+
+func TestValidate_FragmentVariableShadowing_NullableWithDefaultInNonNullPosition(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewFragmentVariableShadowingRule(), `
+      query A($x: Int = 1) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      query B($x: Int!) {
+        complicatedArgs {
+          ...F
+        }
+      }
+      fragment F on ComplicatedArgs {
+        nonNullIntArgField(nonNullIntArg: $x)
+      }
+    `)
+}
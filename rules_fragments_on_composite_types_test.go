@@ -53,7 +53,7 @@ func TestValidate_FragmentsOnCompositeTypes_ScalarIsInvalidFragmentType(t *testi
         bad
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Fragment "scalarFragment" cannot condition on non composite type "Boolean".`, 2, 34),
+		testutil.RuleError(`Fragment "scalarFragment" cannot condition on scalar type "Boolean".`, 2, 34),
 	})
 }
 func TestValidate_FragmentsOnCompositeTypes_EnumIsInvalidFragmentType(t *testing.T) {
@@ -62,7 +62,7 @@ func TestValidate_FragmentsOnCompositeTypes_EnumIsInvalidFragmentType(t *testing
         bad
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Fragment "scalarFragment" cannot condition on non composite type "FurColor".`, 2, 34),
+		testutil.RuleError(`Fragment "scalarFragment" cannot condition on enum type "FurColor".`, 2, 34),
 	})
 }
 func TestValidate_FragmentsOnCompositeTypes_InputObjectIsInvalidFragmentType(t *testing.T) {
@@ -71,7 +71,7 @@ func TestValidate_FragmentsOnCompositeTypes_InputObjectIsInvalidFragmentType(t *
         stringField
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Fragment "inputFragment" cannot condition on non composite type "ComplexInput".`, 2, 33),
+		testutil.RuleError(`Fragment "inputFragment" cannot condition on input type "ComplexInput".`, 2, 33),
 	})
 }
 func TestValidate_FragmentsOnCompositeTypes_ScalarIsInvalidInlineFragmentType(t *testing.T) {
@@ -82,6 +82,26 @@ func TestValidate_FragmentsOnCompositeTypes_ScalarIsInvalidInlineFragmentType(t
         }
       }
     `, []gqlerrors.FormattedError{
-		testutil.RuleError(`Fragment cannot condition on non composite type "String".`, 3, 16),
+		testutil.RuleError(`Fragment cannot condition on scalar type "String".`, 3, 16),
 	})
 }
+func TestValidate_FragmentsOnCompositeTypes_InputObjectIsInvalidInlineFragmentType(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.FragmentsOnCompositeTypesRule, `
+      fragment invalidFragment on Pet {
+        ... on ComplexInput {
+          stringField
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment cannot condition on input type "ComplexInput".`, 3, 16),
+	})
+}
+func TestValidate_FragmentsOnCompositeTypes_InterfaceIsValidInlineFragmentType(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.FragmentsOnCompositeTypesRule, `
+      fragment validFragment on Dog {
+        ... on Pet {
+          name
+        }
+      }
+    `)
+}
@@ -163,3 +163,27 @@ func TestValidate_KnownDirectives_WithinSchemaLanguage_WithMisplacedDirectives(t
 		testutil.RuleError(`Directive "onObject" may not be used on SCHEMA.`, 22, 16),
 	})
 }
+
+func TestValidate_KnownDirectives_WithinSchemaLanguage_WithWellPlacedDeprecated(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.KnownDirectivesRule, `
+        type MyObj {
+          oldField: String @deprecated(reason: "Use newField")
+          newField: String
+        }
+
+        enum MyEnum {
+          OLD_VALUE @deprecated
+          NEW_VALUE
+        }
+    `)
+}
+
+func TestValidate_KnownDirectives_WithinSchemaLanguage_WithMisplacedDeprecated(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.KnownDirectivesRule, `
+        type MyObj @deprecated {
+          myField: String
+        }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Directive "deprecated" may not be used on OBJECT.`, 2, 20),
+	})
+}
@@ -0,0 +1,89 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_MaxFragments_AtTheLimit(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewMaxFragmentsRule(2), `
+      {
+        dog {
+          ...DogName
+          ...DogVolume
+        }
+      }
+      fragment DogName on Dog {
+        name
+      }
+      fragment DogVolume on Dog {
+        barkVolume
+      }
+    `)
+}
+
+func TestValidate_MaxFragments_PastTheLimit(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewMaxFragmentsRule(2), `
+      {
+        dog {
+          ...DogName
+          ...DogVolume
+          ...DogNickname
+        }
+      }
+      fragment DogName on Dog {
+        name
+      }
+      fragment DogVolume on Dog {
+        barkVolume
+      }
+      fragment DogNickname on Dog {
+        nickname
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Document defines 3 fragments, more than the maximum of 2.`, 15, 7),
+	})
+}
+
+func TestValidate_MaxFragments_ZeroAllowsNoFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewMaxFragmentsRule(0), `
+      {
+        dog {
+          ...DogName
+        }
+      }
+      fragment DogName on Dog {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Document defines 1 fragments, more than the maximum of 0.`, 7, 7),
+	})
+}
+
+func TestValidate_MaxFragments_NegativeIsZero(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewMaxFragmentsRule(-1), `
+      {
+        dog {
+          ...DogName
+        }
+      }
+      fragment DogName on Dog {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Document defines 1 fragments, more than the maximum of 0.`, 7, 7),
+	})
+}
+
+func TestValidate_MaxFragments_NegativeAllowsNoFragmentDocument(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewMaxFragmentsRule(-1), `
+      {
+        dog {
+          name
+        }
+      }
+    `)
+}
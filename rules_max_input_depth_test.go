@@ -0,0 +1,42 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_MaxInputDepth_NestingAtTheLimit(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewMaxInputDepthRule(2), `
+      {
+        field(arg: { a: { b: 1 }, c: { d: 2 } })
+      }
+    `)
+}
+func TestValidate_MaxInputDepth_NestingPastTheLimit(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewMaxInputDepthRule(2), `
+      {
+        field(arg: { a: { b: { c: 1 } } })
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Input object is nested deeper than the maximum depth of 2.`, 3, 30),
+	})
+}
+func TestValidate_MaxInputDepth_NestingThroughListValues(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewMaxInputDepthRule(2), `
+      {
+        field(arg: { a: [{ b: 1 }, { b: [{ c: 1 }] }] })
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Input object is nested deeper than the maximum depth of 2.`, 3, 42),
+	})
+}
+func TestValidate_MaxInputDepth_ListOfObjectsAtTheLimit(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewMaxInputDepthRule(2), `
+      {
+        field(arg: [{ a: [{ b: 1 }] }, { a: { b: 2 } }])
+      }
+    `)
+}
@@ -0,0 +1,78 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_MaxRootFields_AtTheLimit(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewMaxRootFieldsRule(2), `
+      query Q {
+        dog {
+          name
+          barkVolume
+        }
+        human {
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_MaxRootFields_PastTheLimit(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewMaxRootFieldsRule(2), `
+      query Q {
+        dog {
+          name
+        }
+        human {
+          name
+        }
+        cat {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Operation "Q" selects 3 root fields, more than the maximum of 2.`, 2, 7),
+	})
+}
+
+func TestValidate_MaxRootFields_CountsFieldsFromFragments(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewMaxRootFieldsRule(2), `
+      {
+        dog {
+          name
+        }
+        ...RootFields
+      }
+      fragment RootFields on QueryRoot {
+        human {
+          name
+        }
+        ... on QueryRoot {
+          cat {
+            name
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Anonymous operation selects 3 root fields, more than the maximum of 2.`, 2, 7),
+	})
+}
+
+func TestValidate_MaxRootFields_CountsEachFragmentOnce(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewMaxRootFieldsRule(1), `
+      {
+        ...RootFields
+        ...RootFields
+      }
+      fragment RootFields on QueryRoot {
+        dog {
+          name
+        }
+      }
+    `)
+}
@@ -176,3 +176,35 @@ func TestValidate_NoCircularFragmentSpreads_NoSpreadingItselfDeeplyAndImmediatel
 			4, 41),
 	})
 }
+func TestValidate_NoCircularFragmentSpreads_NoSpreadingItselfWithinIncludedInlineFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoFragmentCyclesRule, `
+      fragment fragA on Pet {
+        ... on Dog @include(if: true) {
+          ...fragB
+        }
+      }
+      fragment fragB on Dog {
+        name
+        ... @include(if: false) {
+          ...fragA @skip(if: false)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot spread fragment "fragA" within itself via fragB.`, 4, 11, 10, 11),
+	})
+}
+func TestValidate_NoCircularFragmentSpreads_NoSpreadingItselfWithinFieldOfInlineFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoFragmentCyclesRule, `
+      fragment fragA on Human {
+        ... @include(if: true) {
+          relatives {
+            ... on Human {
+              ...fragA
+            }
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot spread fragment "fragA" within itself.`, 6, 15),
+	})
+}
@@ -0,0 +1,67 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_NoMixedRootOperations_QueriesOnly(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNoMixedRootOperationsRule(), `
+      query Foo {
+        dog {
+          name
+        }
+      }
+      query Bar {
+        human {
+          name
+        }
+      }
+    `)
+}
+func TestValidate_NoMixedRootOperations_MutationsOnly(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNoMixedRootOperationsRule(), `
+      mutation Foo {
+        fieldA
+      }
+      mutation Bar {
+        fieldB
+      }
+    `)
+}
+func TestValidate_NoMixedRootOperations_QueryAndMutation(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewNoMixedRootOperationsRule(), `
+      query Foo {
+        dog {
+          name
+        }
+      }
+      mutation Bar {
+        fieldA
+      }
+      mutation Baz {
+        fieldB
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Operation "Bar" is a mutation, but the document already contains a query. `+
+			`Keep queries and mutations in separate documents.`, 7, 7),
+	})
+}
+func TestValidate_NoMixedRootOperations_AnonymousQueryAfterMutation(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewNoMixedRootOperationsRule(), `
+      mutation Foo {
+        fieldA
+      }
+      {
+        dog {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Anonymous operation is a query, but the document already contains a mutation. `+
+			`Keep queries and mutations in separate documents.`, 5, 7),
+	})
+}
@@ -0,0 +1,52 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_NoTypenameAlias_TypenameSelected(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNoTypenameAliasRule(), `
+      {
+        dog {
+          __typename
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_NoTypenameAlias_TypenameAliasedToItself(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNoTypenameAliasRule(), `
+      {
+        dog {
+          __typename: __typename
+        }
+      }
+    `)
+}
+
+func TestValidate_NoTypenameAlias_TypenameAliasedToAnotherName(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNoTypenameAliasRule(), `
+      {
+        dog {
+          type: __typename
+        }
+      }
+    `)
+}
+
+func TestValidate_NoTypenameAlias_FieldAliasedToTypename(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewNoTypenameAliasRule(), `
+      {
+        dog {
+          __typename: name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "name" cannot be aliased to "__typename".`, 4, 11),
+	})
+}
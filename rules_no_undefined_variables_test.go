@@ -263,3 +263,111 @@ func TestValidate_NoUndefinedVariables_VaMultipleUndefinedVariablesProduceMultip
 		testutil.RuleError(`Variable "$b" is not defined by operation "Bar".`, 11, 26, 5, 7),
 	})
 }
+
+func TestValidate_NoUndefinedVariables_VariableInDirectiveOnFragmentSpreadDefined(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUndefinedVariablesRule, `
+      query Foo($show: Boolean!) {
+        dog {
+          ...DogFields @include(if: $show)
+        }
+      }
+      fragment DogFields on Dog {
+        name
+      }
+    `)
+}
+
+func TestValidate_NoUndefinedVariables_VariableInDirectiveOnFragmentSpreadNotDefined(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoUndefinedVariablesRule, `
+      query Foo {
+        dog {
+          ...DogFields @include(if: $show)
+        }
+      }
+      fragment DogFields on Dog {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$show" is not defined by operation "Foo".`, 4, 37, 2, 7),
+	})
+}
+
+func TestValidate_NoUndefinedVariables_VariableInDirectiveOnFragmentDefinitionNotDefined(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoUndefinedVariablesRule, `
+      query Foo {
+        dog {
+          ...DogFields
+        }
+      }
+      fragment DogFields on Dog @include(if: $show) {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$show" is not defined by operation "Foo".`, 7, 46, 2, 7),
+	})
+}
+
+func TestValidate_NoUndefinedVariables_VariableInDirectiveOnFieldDefined(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUndefinedVariablesRule, `
+      query Foo($show: Boolean!) {
+        dog {
+          name @include(if: $show)
+        }
+      }
+    `)
+}
+
+func TestValidate_NoUndefinedVariables_VariableInDirectiveOnFieldNotDefined(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoUndefinedVariablesRule, `
+      query Foo {
+        dog {
+          name @include(if: $show)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$show" is not defined by operation "Foo".`, 4, 29, 2, 7),
+	})
+}
+
+func TestValidate_NoUndefinedVariables_VariableInDirectiveOnFieldInFragmentNotDefined(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoUndefinedVariablesRule, `
+      query Foo {
+        dog {
+          ...DogFields
+        }
+      }
+      fragment DogFields on Dog {
+        name @skip(if: $hide)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$hide" is not defined by operation "Foo".`, 8, 24, 2, 7),
+	})
+}
+
+func TestValidate_NoUndefinedVariables_MultipleAnonymousOperations(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          isHousetrained(atOtherHomes: $a)
+        }
+      }
+      {
+        dog {
+          isHousetrained(atOtherHomes: $b)
+        }
+      }
+    `)
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.LoneAnonymousOperationRule,
+		graphql.NoUndefinedVariablesRule,
+	})
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`This anonymous operation must be the only defined operation.`, 2, 7),
+		testutil.RuleError(`Variable "$a" is not defined.`, 4, 40, 2, 7),
+		testutil.RuleError(`This anonymous operation must be the only defined operation.`, 7, 7),
+		testutil.RuleError(`Variable "$b" is not defined.`, 9, 40, 7, 7),
+	}
+	if !testutil.EqualFormattedErrors(expected, result.Errors) {
+		t.Fatalf("Unexpected errors, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
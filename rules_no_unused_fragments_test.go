@@ -136,3 +136,32 @@ func TestValidate_NoUnusedFragments_ContainsUnknownAndUndefFragments(t *testing.
 		testutil.RuleError(`Fragment "foo" is never used.`, 7, 7),
 	})
 }
+func TestValidate_NoUnusedFragments_IgnoresExternallyUsedFragments(t *testing.T) {
+	testutil.ExpectPassesRuleWithOptions(t, graphql.NoUnusedFragmentsRule, `
+      fragment HumanFields on Human {
+        name
+        ...HumanRelatives
+      }
+      fragment HumanRelatives on Human {
+        relatives {
+          name
+        }
+      }
+    `, &graphql.ValidationOptions{
+		ExternallyUsedFragments: []string{"HumanFields"},
+	})
+}
+func TestValidate_NoUnusedFragments_ReportsFragmentsNotExternallyUsed(t *testing.T) {
+	testutil.ExpectFailsRuleWithOptions(t, graphql.NoUnusedFragmentsRule, `
+      fragment HumanFields on Human {
+        name
+      }
+      fragment Unused on Human {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment "Unused" is never used.`, 5, 7),
+	}, &graphql.ValidationOptions{
+		ExternallyUsedFragments: []string{"HumanFields", "UnknownFragment"},
+	})
+}
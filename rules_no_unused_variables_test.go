@@ -187,3 +187,73 @@ func TestValidate_NoUnusedVariables_VariableNotUsedByFragmentUsedByOtherOperatio
 		testutil.RuleError(`Variable "$a" is never used in operation "Bar".`, 5, 17),
 	})
 }
+
+func TestValidate_NoUnusedVariables_UsesVariableOnlyInDirectiveOnFragmentSpread(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($show: Boolean!) {
+        dog {
+          ...DogFields @include(if: $show)
+        }
+      }
+      fragment DogFields on Dog {
+        name
+      }
+    `)
+}
+
+func TestValidate_NoUnusedVariables_UsesVariableOnlyInDirectiveOnFragmentDefinition(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($show: Boolean!) {
+        dog {
+          ...DogFields
+        }
+      }
+      fragment DogFields on Dog @include(if: $show) {
+        name
+      }
+    `)
+}
+
+func TestValidate_NoUnusedVariables_UsesVariableOnlyInSkipDirectiveOnField(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($unused: Boolean!) {
+        dog {
+          name @skip(if: $unused)
+        }
+      }
+    `)
+}
+
+func TestValidate_NoUnusedVariables_UsesVariableOnlyInDirectiveOnInlineFragment(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($show: Boolean!) {
+        dog {
+          ... on Dog @include(if: $show) {
+            name
+          }
+        }
+      }
+    `)
+}
+
+func TestValidate_NoUnusedVariables_UsesVariableOnlyInDirectiveOnOperation(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($show: Boolean!) @onQuery(if: $show) {
+        dog {
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_NoUnusedVariables_VariableNotUsedBesideDirectiveUsage(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NoUnusedVariablesRule, `
+      query Foo($skipped: Boolean!, $unused: Boolean!) {
+        dog {
+          name @skip(if: $skipped)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$unused" is never used in operation "Foo".`, 2, 37),
+	})
+}
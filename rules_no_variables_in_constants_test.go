@@ -0,0 +1,65 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_NoVariablesInConstants_VariablesInOperationAndFragment(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NoVariablesInConstantsRule, `
+      query Q($atOtherHomes: Boolean, $skip: Boolean = false) {
+        dog @skip(if: $skip) {
+          ...dogFields
+        }
+      }
+      fragment dogFields on Dog {
+        isHousetrained(atOtherHomes: $atOtherHomes)
+      }
+    `)
+}
+
+func TestValidate_NoVariablesInConstants_ConstantDirectiveArgumentInTypeSystem(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.NoVariablesInConstantsRule, `
+      type Query {
+        oldField: String @deprecated(reason: "Use newField.")
+        newField: String
+      }
+    `)
+}
+
+func TestValidate_NoVariablesInConstants_VariableDirectiveArgumentInTypeSystem(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.NoVariablesInConstantsRule, `
+      type Query {
+        oldField: String @deprecated(reason: $reason)
+        newField: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$reason" is not allowed in this context.`, 3, 46),
+	})
+}
+
+func TestValidate_NoVariablesInConstants_VariableInDefaultValue(t *testing.T) {
+	// The parser rejects variables in default values, so build the default
+	// value from the other variable of the operation.
+	doc := testutil.TestParse(t, `
+      query Q($atOtherHomes: Boolean, $other: Boolean) {
+        dog {
+          isHousetrained(atOtherHomes: $atOtherHomes)
+        }
+      }
+    `)
+	varDefs := doc.Definitions[0].(*ast.OperationDefinition).VariableDefinitions
+	varDefs[0].DefaultValue = varDefs[1].Variable
+
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{graphql.NoVariablesInConstantsRule})
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$other" is not allowed in this context.`, 2, 39),
+	}
+	if !testutil.EqualFormattedErrors(expected, result.Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
@@ -0,0 +1,77 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_NonEmptyOperation_SelectsField(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNonEmptyOperationRule(), `
+      query Q {
+        dog {
+          name
+        }
+      }
+    `)
+}
+func TestValidate_NonEmptyOperation_SelectsFieldThroughFragment(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNonEmptyOperationRule(), `
+      query Q {
+        ...dogFragment
+      }
+      fragment dogFragment on QueryRoot {
+        dog {
+          name
+        }
+      }
+    `)
+}
+func TestValidate_NonEmptyOperation_OnlySpreadOfUnknownFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewNonEmptyOperationRule(), `
+      query Q {
+        ...unknownFragment
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Operation "Q" must select at least one field.`, 2, 7),
+	})
+}
+func TestValidate_NonEmptyOperation_OnlySpreadOfFragmentSelectingNothing(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewNonEmptyOperationRule(), `
+      {
+        ...emptyFragment
+      }
+      fragment emptyFragment on QueryRoot {
+        ...unknownFragment
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Anonymous operation must select at least one field.`, 2, 7),
+	})
+}
+
+// The parser rejects empty selection sets, so the test empties the one of the
+// parsed operation.
+func TestValidate_NonEmptyOperation_EmptyOperation(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      query Q {
+        dog {
+          name
+        }
+      }
+    `)
+	operation := doc.Definitions[0].(*ast.OperationDefinition)
+	operation.SelectionSet.Selections = []ast.Selection{}
+
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.NewNonEmptyOperationRule(),
+	})
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`Operation "Q" must select at least one field.`, 2, 7),
+	}
+	if result.IsValid || !testutil.EqualFormattedErrors(expected, result.Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
@@ -0,0 +1,59 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_NonEmptySelectionSet_NonEmptySelectionSet(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNonEmptySelectionSetRule(), `
+      {
+        human {
+          name
+          pets {
+            name
+          }
+        }
+      }
+    `)
+}
+func TestValidate_NonEmptySelectionSet_LeafField(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewNonEmptySelectionSetRule(), `
+      {
+        dog {
+          barkVolume
+        }
+      }
+    `)
+}
+
+// The parser rejects empty selection sets, so the test empties one of the
+// parsed document.
+func TestValidate_NonEmptySelectionSet_EmptySelectionSet(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        human {
+          pets {
+            name
+          }
+        }
+      }
+    `)
+	human := doc.Definitions[0].(*ast.OperationDefinition).SelectionSet.Selections[0].(*ast.Field)
+	pets := human.SelectionSet.Selections[0].(*ast.Field)
+	pets.SelectionSet.Selections = []ast.Selection{}
+
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.NewNonEmptySelectionSetRule(),
+	})
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "pets" must select at least one subfield.`, 4, 11),
+	}
+	if result.IsValid || !testutil.EqualFormattedErrors(expected, result.Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
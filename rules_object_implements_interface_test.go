@@ -0,0 +1,125 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_ObjectImplementsInterface_CorrectImplementation(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.ObjectImplementsInterfaceRule, `
+      interface Node {
+        id: ID!
+        friends(first: Int): [Node]
+      }
+      type User implements Node {
+        id: ID!
+        friends(first: Int, after: String): [User!]
+      }
+    `)
+}
+func TestValidate_ObjectImplementsInterface_FieldAddedByExtension(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.ObjectImplementsInterfaceRule, `
+      interface Node {
+        id: ID!
+      }
+      type User {
+        name: String
+      }
+      extend type User implements Node {
+        id: ID!
+      }
+    `)
+}
+func TestValidate_ObjectImplementsInterface_InterfaceOfSchema(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, testutil.TestSchema, graphql.ObjectImplementsInterfaceRule, `
+      type Hamster implements Pet {
+        name(surname: Boolean): String
+      }
+    `)
+}
+func TestValidate_ObjectImplementsInterface_MissingField(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.ObjectImplementsInterfaceRule, `
+      interface Node {
+        id: ID!
+      }
+      type User implements Node {
+        name: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Interface field "Node.id" expected but "User" does not provide it.`, 3, 9, 5, 28),
+	})
+}
+func TestValidate_ObjectImplementsInterface_IncompatibleReturnType(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.ObjectImplementsInterfaceRule, `
+      interface Node {
+        id: ID!
+        parent: Node
+      }
+      type Group {
+        id: ID!
+      }
+      type User implements Node {
+        id: ID
+        parent: Group
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Interface field "Node.id" expects type "ID!" but "User.id" is type "ID".`, 3, 9, 10, 9),
+		testutil.RuleError(`Interface field "Node.parent" expects type "Node" but "User.parent" is type "Group".`, 4, 9, 11, 9),
+	})
+}
+func TestValidate_ObjectImplementsInterface_IncompatibleArguments(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.ObjectImplementsInterfaceRule, `
+      interface Node {
+        friends(first: Int, after: String): [Node]
+      }
+      type User implements Node {
+        friends(first: String, last: Int!): [User]
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Interface field argument "Node.friends(first:)" expects type "Int" but "User.friends(first:)" is type "String".`, 3, 17, 6, 17),
+		testutil.RuleError(`Interface field argument "Node.friends(after:)" expected but "User.friends" does not provide it.`, 3, 29, 6, 9),
+		testutil.RuleError(`Object field "User.friends" includes required argument "last" that is missing from the Interface field "Node.friends".`, 6, 32, 3, 9),
+	})
+}
+func TestValidate_ObjectImplementsInterface_InterfaceFieldOfSchema(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, testutil.TestSchema, graphql.ObjectImplementsInterfaceRule, `
+      type Hamster implements Pet {
+        name: Int
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Interface field "Pet.name" expects type "String" but "Hamster.name" is type "Int".`, 3, 9),
+		testutil.RuleError(`Interface field argument "Pet.name(surname:)" expected but "Hamster.name" does not provide it.`, 3, 9),
+	})
+}
+func TestValidate_ObjectImplementsInterface_InterfaceDeclaredByTypeAndExtension(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.ObjectImplementsInterfaceRule, `
+      interface Node {
+        id: ID!
+      }
+      type User implements Node {
+        name: String
+      }
+      extend type User implements Node {
+        email: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Interface field "Node.id" expected but "User" does not provide it.`, 3, 9, 5, 28),
+	})
+}
+func TestValidate_ObjectImplementsInterface_UnionMemberReturnType(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.ObjectImplementsInterfaceRule, `
+      union Owner = User | Group
+      interface Owned {
+        owner: Owner
+      }
+      type Group {
+        id: ID
+      }
+      type User implements Owned {
+        owner: User
+      }
+    `)
+}
@@ -0,0 +1,119 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_OperationTypeExists_QueryOperation(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewOperationTypeExistsRule(), `
+      query Foo {
+        dog {
+          name
+        }
+      }
+    `)
+}
+func TestValidate_OperationTypeExists_MissingMutationRoot(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewOperationTypeExistsRule(), `
+      mutation Foo {
+        dog {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Schema is not configured for mutations.`, 2, 7),
+	})
+}
+func TestValidate_OperationTypeExists_MissingSubscriptionRoot(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewOperationTypeExistsRule(), `
+      subscription Foo {
+        dog {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Schema is not configured for subscriptions.`, 2, 7),
+	})
+}
+func TestValidate_OperationTypeExists_ConfiguredMutationRoot(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{Type: graphql.String},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"b": &graphql.Field{Type: graphql.String},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.NewOperationTypeExistsRule(), `
+      mutation Foo {
+        b
+      }
+    `)
+}
+
+func TestRootType_ReturnsTheRootTypeOfEachOperationType(t *testing.T) {
+	rootTypes := map[string]*graphql.Object{}
+	for _, name := range []string{"Query", "Mutation", "Subscription"} {
+		rootTypes[name] = graphql.NewObject(graphql.ObjectConfig{
+			Name: name,
+			Fields: graphql.Fields{
+				"a": &graphql.Field{Type: graphql.String},
+			},
+		})
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query:        rootTypes["Query"],
+		Mutation:     rootTypes["Mutation"],
+		Subscription: rootTypes["Subscription"],
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	doc := testutil.TestParse(t, `
+      query Q { a }
+      mutation M { a }
+      subscription S { a }
+    `)
+	for i, expected := range []string{"Query", "Mutation", "Subscription"} {
+		op := doc.Definitions[i].(*ast.OperationDefinition)
+		ttype, err := graphql.RootType(&schema, op)
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", op.Operation, err)
+		}
+		if ttype != graphql.Named(rootTypes[expected]) {
+			t.Fatalf("Expected the %v type for %v, got: %v", expected, op.Operation, ttype)
+		}
+	}
+}
+func TestRootType_MissingSubscriptionRoot(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      subscription Foo {
+        dog {
+          name
+        }
+      }
+    `)
+	op := doc.Definitions[0].(*ast.OperationDefinition)
+	ttype, err := graphql.RootType(testutil.TestSchema, op)
+	if ttype != nil {
+		t.Fatalf("Expected no root type, got: %v", ttype)
+	}
+	expected := testutil.RuleError(`Schema is not configured for subscriptions.`, 2, 7)
+	if err == nil || !testutil.EqualFormattedError(expected, gqlerrors.FormatError(err)) {
+		t.Fatalf("Expected error %v, got: %v", expected, err)
+	}
+}
@@ -10,9 +10,8 @@ import (
 	"github.com/graphql-go/graphql/language/visitor"
 )
 
-func fieldsConflictMessage(responseName string, reason conflictReason) string {
-	return fmt.Sprintf(`Fields "%v" conflict because %v. `+
-		`Use different aliases on the fields to fetch both if this was intentional.`,
+func fieldsConflictMessage(formatter MessageFormatter, responseName string, reason conflictReason) string {
+	return formatter.Format("OverlappingFieldsCanBeMerged",
 		responseName,
 		fieldsConflictReasonMessage(reason),
 	)
@@ -50,6 +49,10 @@ func OverlappingFieldsCanBeMergedRule(context *ValidationContext) *ValidationRul
 	// dramatically improve the performance of this validator.
 	comparedSet := newPairSet()
 
+	// A memoization for when a set of fields is compared with a fragment, which
+	// also ends cycles of fragments spreading each other.
+	comparedFieldsAndFragments := map[*fieldsAndFragmentNames]map[string]bool{}
+
 	// A cache for the "field map" and list of fragment names found in any given
 	// selection set. Selection sets may be asked for this information multiple
 	// times, so this improves the performance of this validator.
@@ -63,9 +66,10 @@ func OverlappingFieldsCanBeMergedRule(context *ValidationContext) *ValidationRul
 						parentType, _ := context.ParentType().(Named)
 
 						rule := &overlappingFieldsCanBeMergedRule{
-							context:     context,
-							comparedSet: comparedSet,
-							cacheMap:    cacheMap,
+							context:                    context,
+							comparedSet:                comparedSet,
+							comparedFieldsAndFragments: comparedFieldsAndFragments,
+							cacheMap:                   cacheMap,
 						}
 						conflicts := rule.findConflictsWithinSelectionSet(parentType, selectionSet)
 						if len(conflicts) > 0 {
@@ -74,7 +78,7 @@ func OverlappingFieldsCanBeMergedRule(context *ValidationContext) *ValidationRul
 								reason := c.Reason
 								reportError(
 									context,
-									fieldsConflictMessage(responseName, reason),
+									fieldsConflictMessage(context.messageFormatter(), responseName, reason),
 									append(c.FieldsLeft, c.FieldsRight...),
 								)
 							}
@@ -154,6 +158,10 @@ type overlappingFieldsCanBeMergedRule struct {
 	// dramatically improve the performance of this validator.
 	comparedSet *pairSet
 
+	// A memoization for when a set of fields is compared with a fragment,
+	// recording whether the comparison assumed mutually exclusive parents.
+	comparedFieldsAndFragments map[*fieldsAndFragmentNames]map[string]bool
+
 	// A cache for the "field map" and list of fragment names found in any given
 	// selection set. Selection sets may be asked for this information multiple
 	// times, so this improves the performance of this validator.
@@ -197,6 +205,19 @@ func (rule *overlappingFieldsCanBeMergedRule) collectConflictsBetweenFieldsAndFr
 		return conflicts
 	}
 
+	// Memoize so the fields and the fragment are not compared more than once.
+	// As for pairSet, a comparison which didn't assume mutually exclusive
+	// parents covers one which does.
+	compared, ok := rule.comparedFieldsAndFragments[fieldsInfo]
+	if !ok {
+		compared = map[string]bool{}
+		rule.comparedFieldsAndFragments[fieldsInfo] = compared
+	}
+	if wasMutuallyExclusive, ok := compared[fragmentName]; ok && (areMutuallyExclusive || !wasMutuallyExclusive) {
+		return conflicts
+	}
+	compared[fragmentName] = areMutuallyExclusive
+
 	fieldsInfo2 := rule.getReferencedFieldsAndFragmentNames(fragment)
 
 	// (D) First collect any conflicts between the provided collection of fields
@@ -206,7 +227,7 @@ func (rule *overlappingFieldsCanBeMergedRule) collectConflictsBetweenFieldsAndFr
 	// (E) Then collect any conflicts between the provided collection of fields
 	// and any fragment names found in the given fragment.
 	for _, fragmentName2 := range fieldsInfo2.fragmentNames {
-		conflicts = rule.collectConflictsBetweenFieldsAndFragment(conflicts, areMutuallyExclusive, fieldsInfo2, fragmentName2)
+		conflicts = rule.collectConflictsBetweenFieldsAndFragment(conflicts, areMutuallyExclusive, fieldsInfo, fragmentName2)
 	}
 
 	return conflicts
@@ -432,11 +453,24 @@ func (rule *overlappingFieldsCanBeMergedRule) findConflict(parentFieldsAreMutual
 		}
 	}
 
+	// A field selected as a leaf and with a sub-selection would have to be
+	// returned in two different response shapes.
+	selectionSet1 := ast1.SelectionSet
+	selectionSet2 := ast2.SelectionSet
+	if (selectionSet1 == nil) != (selectionSet2 == nil) {
+		return &conflict{
+			Reason: conflictReason{
+				Name:    responseName,
+				Message: `one has a sub selection and the other does not`,
+			},
+			FieldsLeft:  []ast.Node{ast1},
+			FieldsRight: []ast.Node{ast2},
+		}
+	}
+
 	// Collect and compare sub-fields. Use the same "visited fragment names" list
 	// for both collections so fields in a fragment reference are never
 	// compared to themselves.
-	selectionSet1 := ast1.SelectionSet
-	selectionSet2 := ast2.SelectionSet
 	if selectionSet1 != nil && selectionSet2 != nil {
 		conflicts := rule.findConflictsBetweenSubSelectionSets(areMutuallyExclusive, GetNamed(type1), selectionSet1, GetNamed(type2), selectionSet2)
 		return subfieldConflicts(conflicts, responseName, ast1, ast2)
@@ -504,7 +538,7 @@ func (rule *overlappingFieldsCanBeMergedRule) getFieldsAndFragmentNames(parentTy
 				typeCondition := selection.TypeCondition
 				inlineFragmentType := parentType
 				if typeCondition != nil {
-					ttype, err := typeFromAST(*(rule.context.Schema()), typeCondition)
+					ttype, err := rule.context.typeFromAST(typeCondition)
 					if err == nil {
 						inlineFragmentType, _ = ttype.(Named)
 					}
@@ -530,13 +564,95 @@ func (rule *overlappingFieldsCanBeMergedRule) getReferencedFieldsAndFragmentName
 	if cached, ok := rule.cacheMap[fragment.SelectionSet]; ok && cached != nil {
 		return cached
 	}
-	fragmentType, err := typeFromAST(*(rule.context.Schema()), fragment.TypeCondition)
+	fragmentType, err := rule.context.typeFromAST(fragment.TypeCondition)
 	if err != nil {
 		return nil
 	}
 	return rule.getFieldsAndFragmentNames(fragmentType, fragment.SelectionSet)
 }
 
+// FieldAndDef A field selected by a selection set, along with the type it is
+// selected on and its definition, nil when the type has no such field.
+type FieldAndDef struct {
+	ParentType Named
+	Field      *ast.Field
+	FieldDef   *FieldDefinition
+}
+
+// CollectFields Resolves the selection set on the given parent type into the
+// fields it selects by response name, e.g. for cost analysis or projections.
+// Inline fragments and the fragments it spreads are expanded, each field
+// keeping the type it is selected on; fields selected several times under the
+// same response name are all listed, in document order.
+func CollectFields(context *ValidationContext, parentType Named, selectionSet *ast.SelectionSet) map[string][]FieldAndDef {
+	fields := map[string][]FieldAndDef{}
+	visitedFragmentNames := map[string]bool{}
+
+	var collect func(parentType Named, selectionSet *ast.SelectionSet)
+	collect = func(parentType Named, selectionSet *ast.SelectionSet) {
+		if selectionSet == nil {
+			return
+		}
+		for _, selection := range selectionSet.Selections {
+			switch selection := selection.(type) {
+			case *ast.Field:
+				fieldName := ""
+				if selection.Name != nil {
+					fieldName = selection.Name.Value
+				}
+				var fieldDef *FieldDefinition
+				if parentType, ok := parentType.(*Object); ok && parentType != nil {
+					fieldDef, _ = parentType.Fields()[fieldName]
+				}
+				if parentType, ok := parentType.(*Interface); ok && parentType != nil {
+					fieldDef, _ = parentType.Fields()[fieldName]
+				}
+				responseName := fieldName
+				if selection.Alias != nil {
+					responseName = selection.Alias.Value
+				}
+				fields[responseName] = append(fields[responseName], FieldAndDef{
+					ParentType: parentType,
+					Field:      selection,
+					FieldDef:   fieldDef,
+				})
+			case *ast.FragmentSpread:
+				fragmentName := ""
+				if selection.Name != nil {
+					fragmentName = selection.Name.Value
+				}
+				if visitedFragmentNames[fragmentName] {
+					continue
+				}
+				visitedFragmentNames[fragmentName] = true
+				fragment := context.Fragment(fragmentName)
+				if fragment == nil {
+					continue
+				}
+				collect(collectedFragmentType(context, parentType, fragment.TypeCondition), fragment.SelectionSet)
+			case *ast.InlineFragment:
+				collect(collectedFragmentType(context, parentType, selection.TypeCondition), selection.SelectionSet)
+			}
+		}
+	}
+	collect(parentType, selectionSet)
+	return fields
+}
+
+// collectedFragmentType Returns the type fields of a fragment are selected on:
+// its type condition, or else the enclosing type.
+func collectedFragmentType(context *ValidationContext, parentType Named, typeCondition *ast.Named) Named {
+	if typeCondition == nil || context.Schema() == nil {
+		return parentType
+	}
+	ttype, err := context.typeFromAST(typeCondition)
+	if err != nil {
+		return parentType
+	}
+	fragmentType, _ := ttype.(Named)
+	return fragmentType
+}
+
 type conflictReason struct {
 	Name    string
 	Message interface{} // conflictReason || []conflictReason
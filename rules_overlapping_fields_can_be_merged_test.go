@@ -1,10 +1,12 @@
 package graphql_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -388,6 +390,46 @@ func TestValidate_OverlappingFieldsCanBeMerged_IgnoresUnknownFragments(t *testin
     }
     `)
 }
+func TestValidate_OverlappingFieldsCanBeMerged_LeafAndSubSelectionConflict(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.OverlappingFieldsCanBeMergedRule, `
+      {
+        ...F
+        user {
+          id
+        }
+      }
+      fragment F on T {
+        user
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fields "user" conflict because one has a sub selection and the other does not. `+
+			`Use different aliases on the fields to fetch both if this was intentional.`,
+			4, 9,
+			9, 9),
+	})
+}
+func TestValidate_OverlappingFieldsCanBeMerged_LeafAndSubSelectionConflictInSubfields(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.OverlappingFieldsCanBeMergedRule, `
+      {
+        field {
+          user
+        }
+        field {
+          user {
+            id
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fields "field" conflict because subfields "user" conflict because `+
+			`one has a sub selection and the other does not. `+
+			`Use different aliases on the fields to fetch both if this was intentional.`,
+			3, 9,
+			4, 11,
+			6, 9,
+			7, 11),
+	})
+}
 
 var someBoxInterface *graphql.Interface
 var stringBoxObject *graphql.Object
@@ -618,6 +660,70 @@ func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_Comp
       }
     `)
 }
+func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_AllowsCovariantObjectTypesFromDifferentImplementations(t *testing.T) {
+	// `deepBox` returns the SomeBox interface on IntBox but the StringBox
+	// object on StringBox. Composite return types don't need to be identical,
+	// only their selection sets are compared.
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.OverlappingFieldsCanBeMergedRule, `
+      {
+        someBox {
+          ... on IntBox {
+            deepBox {
+              unrelatedField
+            }
+          }
+          ... on StringBox {
+            deepBox {
+              unrelatedField
+            }
+          }
+        }
+      }
+    `)
+}
+func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_AllowsDifferentObjectTypesWithSameShape(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.OverlappingFieldsCanBeMergedRule, `
+      {
+        someBox {
+          ... on IntBox {
+            box: intBox {
+              unrelatedField
+            }
+          }
+          ... on StringBox {
+            box: stringBox {
+              unrelatedField
+            }
+          }
+        }
+      }
+    `)
+}
+func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_ComparesLeafTypesWithinDifferentObjectTypes(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.OverlappingFieldsCanBeMergedRule, `
+      {
+        someBox {
+          ... on IntBox {
+            box: intBox {
+              scalar
+            }
+          }
+          ... on StringBox {
+            box: stringBox {
+              scalar
+            }
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fields "box" conflict because subfields "scalar" conflict because they return conflicting types Int and String. `+
+			`Use different aliases on the fields to fetch both if this was intentional.`,
+			5, 13,
+			6, 15,
+			10, 13,
+			11, 15),
+	})
+}
 func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_DisallowsDifferingReturnTypesDespiteNoOverlap(t *testing.T) {
 	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.OverlappingFieldsCanBeMergedRule, `
         {
@@ -892,3 +998,161 @@ func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_Igno
 func TestValidate_OverlappingFieldsCanBeMerged_NilCrash(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.OverlappingFieldsCanBeMergedRule, `subscription {e}`)
 }
+func TestValidate_OverlappingFieldsCanBeMerged_ReportsConflictWithNestedFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.OverlappingFieldsCanBeMergedRule, `
+      {
+        field {
+          x: a
+          ...F1
+        }
+      }
+      fragment F1 on T {
+        ...F2
+      }
+      fragment F2 on T {
+        x: b
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fields "x" conflict because a and b are different fields. `+
+			`Use different aliases on the fields to fetch both if this was intentional.`,
+			4, 11,
+			12, 9),
+	})
+}
+func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_AllowsSameFieldOnInterfaceAndImplementation(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.OverlappingFieldsCanBeMergedRule, `
+        {
+          someBox {
+            unrelatedField
+            ... on StringBox {
+              unrelatedField
+            }
+            ... on IntBox {
+              unrelatedField
+            }
+          }
+        }
+    `)
+}
+func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_AllowsDifferentFieldsOnDifferentImplementationsViaFragments(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, &schema, graphql.OverlappingFieldsCanBeMergedRule, `
+        {
+          someBox {
+            ...StringBoxFields
+            ... on IntBox {
+              val: unrelatedField
+            }
+          }
+        }
+        fragment StringBoxFields on StringBox {
+          ...StringBoxScalar
+        }
+        fragment StringBoxScalar on StringBox {
+          val: scalar
+        }
+    `)
+}
+func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_DisallowsDifferentFieldsOnInterfaceAndImplementation(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, &schema, graphql.OverlappingFieldsCanBeMergedRule, `
+        {
+          someBox {
+            val: unrelatedField
+            ...StringBoxFields
+          }
+        }
+        fragment StringBoxFields on StringBox {
+          ...StringBoxScalar
+        }
+        fragment StringBoxScalar on StringBox {
+          val: scalar
+        }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fields "val" conflict because unrelatedField and scalar are different fields. `+
+			`Use different aliases on the fields to fetch both if this was intentional.`,
+			4, 13,
+			12, 11),
+	})
+}
+func TestValidate_OverlappingFieldsCanBeMerged_ReportsBothFieldLocationsInSourceOrder(t *testing.T) {
+	doc := testutil.TestParse(t, `
+        {
+          someBox {
+            ... on IntBox {
+              scalar
+            }
+            ... on StringBox {
+              scalar
+            }
+          }
+        }
+    `)
+	result := graphql.ValidateDocument(&schema, doc, []graphql.ValidationRuleFn{graphql.OverlappingFieldsCanBeMergedRule})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected exactly one error, got %v", result.Errors)
+	}
+	locations := result.Errors[0].Locations
+	if len(locations) != 2 {
+		t.Fatalf("Expected two locations, got %v", locations)
+	}
+	if locations[0].Line != 5 || locations[1].Line != 8 {
+		t.Fatalf("Expected locations on lines 5 and 8 in source order, got %v", locations)
+	}
+}
+
+func TestCollectFields_ExpandsFragments(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        pet {
+          name
+          ...DogFields
+          ... on Cat {
+            meowVolume
+            name
+          }
+        }
+      }
+      fragment DogFields on Dog {
+        volume: barkVolume
+      }
+    `)
+	typeInfo := graphql.NewTypeInfo(&graphql.TypeInfoConfig{
+		Schema: testutil.TestSchema,
+	})
+	context := graphql.NewValidationContext(testutil.TestSchema, doc, typeInfo)
+	operation := doc.Definitions[0].(*ast.OperationDefinition)
+	petField := operation.SelectionSet.Selections[0].(*ast.Field)
+	petType := testutil.TestSchema.Type("Pet")
+
+	fields := graphql.CollectFields(context, petType, petField.SelectionSet)
+
+	type collected struct {
+		ParentType string
+		FieldName  string
+		HasDef     bool
+	}
+	result := map[string][]collected{}
+	for responseName, fieldAndDefs := range fields {
+		for _, fieldAndDef := range fieldAndDefs {
+			result[responseName] = append(result[responseName], collected{
+				ParentType: fieldAndDef.ParentType.String(),
+				FieldName:  fieldAndDef.Field.Name.Value,
+				HasDef:     fieldAndDef.FieldDef != nil,
+			})
+		}
+	}
+	expected := map[string][]collected{
+		"name": {
+			{ParentType: "Pet", FieldName: "name", HasDef: true},
+			{ParentType: "Cat", FieldName: "name", HasDef: true},
+		},
+		"volume": {
+			{ParentType: "Dog", FieldName: "barkVolume", HasDef: true},
+		},
+		"meowVolume": {
+			{ParentType: "Cat", FieldName: "meowVolume", HasDef: true},
+		},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected collected fields, Diff: %v", testutil.Diff(expected, result))
+	}
+}
@@ -0,0 +1,130 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func persistedOperations(t *testing.T, queryString string) map[string]bool {
+	allowed := map[string]bool{}
+	doc := testutil.TestParse(t, queryString)
+	for _, definition := range doc.Definitions {
+		if operation, ok := definition.(*ast.OperationDefinition); ok {
+			allowed[graphql.PersistedOperationHash(doc, operation)] = true
+		}
+	}
+	return allowed
+}
+
+func TestValidate_PersistedOperations_AllowedOperation(t *testing.T) {
+	allowed := persistedOperations(t, `query Dog { dog { name } }`)
+	testutil.ExpectPassesRule(t, graphql.NewPersistedOperationsRule(allowed), `
+      query Dog {
+        dog {
+          name
+        }
+      }
+    `)
+}
+func TestValidate_PersistedOperations_TweakedOperation(t *testing.T) {
+	allowed := persistedOperations(t, `query Dog { dog { name } }`)
+	testutil.ExpectFailsRule(t, graphql.NewPersistedOperationsRule(allowed), `
+      query Dog {
+        dog {
+          name
+          barkVolume
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Operation not allowed.`, 2, 7),
+	})
+}
+func TestValidate_PersistedOperations_ReportsEachOperation(t *testing.T) {
+	allowed := persistedOperations(t, `query Dog { dog { name } }`)
+	testutil.ExpectFailsRule(t, graphql.NewPersistedOperationsRule(allowed), `
+      query Dog {
+        dog {
+          name
+        }
+      }
+      query Human {
+        human {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Operation not allowed.`, 7, 7),
+	})
+}
+func TestValidate_PersistedOperations_AllowedOperationWithFragment(t *testing.T) {
+	allowed := persistedOperations(t, `
+      query Dog { dog { ...DogFields } }
+      fragment DogFields on Dog { ...DogName }
+      fragment DogName on Dog { name }
+    `)
+	testutil.ExpectPassesRule(t, graphql.NewPersistedOperationsRule(allowed), `
+      query Dog {
+        dog {
+          ...DogFields
+        }
+      }
+      fragment DogName on Dog {
+        name
+      }
+      fragment DogFields on Dog {
+        ...DogName
+      }
+    `)
+}
+func TestValidate_PersistedOperations_TweakedFragment(t *testing.T) {
+	allowed := persistedOperations(t, `
+      query Dog { dog { ...DogFields } }
+      fragment DogFields on Dog { ...DogName }
+      fragment DogName on Dog { name }
+    `)
+	testutil.ExpectFailsRule(t, graphql.NewPersistedOperationsRule(allowed), `
+      query Dog {
+        dog {
+          ...DogFields
+        }
+      }
+      fragment DogFields on Dog {
+        ...DogName
+      }
+      fragment DogName on Dog {
+        name
+        barkVolume
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Operation not allowed.`, 2, 7),
+	})
+}
+func TestPersistedOperationHash_CoversSpreadFragments(t *testing.T) {
+	hash := func(queryString string) string {
+		doc := testutil.TestParse(t, queryString)
+		return graphql.PersistedOperationHash(doc, doc.Definitions[0].(*ast.OperationDefinition))
+	}
+	original := hash(`
+      query Dog { dog { ...DogFields } }
+      fragment DogFields on Dog { name }
+    `)
+	tweaked := hash(`
+      query Dog { dog { ...DogFields } }
+      fragment DogFields on Dog { name barkVolume }
+    `)
+	if original == tweaked {
+		t.Fatalf("Expected the hash to change with the body of the spread fragment")
+	}
+	unrelated := hash(`
+      query Dog { dog { ...DogFields } }
+      fragment DogFields on Dog { name }
+      fragment Unrelated on Dog { barkVolume }
+    `)
+	if original != unrelated {
+		t.Fatalf("Expected the hash not to depend on fragments the operation doesn't spread")
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -180,3 +181,64 @@ func TestValidate_PossibleFragmentSpreads_InterfaceIntoNonOverlappingUnion(t *te
 			`type "HumanOrAlien" can never be of type "Pet".`, 2, 62),
 	})
 }
+
+func TestValidate_PossibleFragmentSpreads_NestedInlineFragmentNarrowsParentType(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.PossibleFragmentSpreadsRule, `
+      fragment nestedNarrowing on Pet {
+        ... on Dog {
+          ... on Cat {
+            meowVolume
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment cannot be spread here as objects of `+
+			`type "Dog" can never be of type "Cat".`, 4, 11),
+	})
+}
+
+func TestValidate_PossibleFragmentSpreads_SiblingInlineFragmentsOnMutuallyExclusiveObjects(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.PossibleFragmentSpreadsRule, `
+      fragment siblingFragments on Pet {
+        ... on Dog {
+          barkVolume
+        }
+        ... on Cat {
+          meowVolume
+        }
+      }
+    `)
+}
+
+func TestValidate_PossibleFragmentSpreads_SiblingInlineFragmentsWithinObject(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.PossibleFragmentSpreadsRule, `
+      fragment siblingFragments on Dog {
+        ... on Dog {
+          barkVolume
+        }
+        ... on Cat {
+          meowVolume
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment cannot be spread here as objects of `+
+			`type "Dog" can never be of type "Cat".`, 6, 9),
+	})
+}
+
+func BenchmarkPossibleFragmentSpreads_ManySpreadsOnInterfaces(b *testing.B) {
+	query := "{\n"
+	for i := 0; i < 100; i++ {
+		query += "  pet { ...PetFields ... on Being { name } ... on Canine { name } }\n"
+	}
+	query += "}\nfragment PetFields on Pet { ... on Dog { name } ... on Cat { name } }\n"
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		b.Fatal(err)
+	}
+	rules := []graphql.ValidationRuleFn{graphql.PossibleFragmentSpreadsRule}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		graphql.ValidateDocument(testutil.TestSchema, doc, rules)
+	}
+}
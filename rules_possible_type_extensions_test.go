@@ -0,0 +1,76 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_PossibleTypeExtensions_ExtendingDefinedObject(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.PossibleTypeExtensionsRule, `
+      type Foo {
+        a: String
+      }
+      extend type Foo {
+        b: String
+      }
+    `)
+}
+func TestValidate_PossibleTypeExtensions_ExtendingObjectDefinedLater(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.PossibleTypeExtensionsRule, `
+      extend type Foo {
+        b: String
+      }
+      type Foo {
+        a: String
+      }
+    `)
+}
+func TestValidate_PossibleTypeExtensions_ExtendingObjectOfSchema(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, testutil.TestSchema, graphql.PossibleTypeExtensionsRule, `
+      extend type Dog {
+        age: Int
+      }
+    `)
+}
+func TestValidate_PossibleTypeExtensions_ExtendingUndefinedType(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.PossibleTypeExtensionsRule, `
+      type Known {
+        a: String
+      }
+      extend type Missing {
+        b: String
+      }
+      extend type Knwn {
+        b: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot extend type "Missing" because it is not defined.`, 5, 19),
+		testutil.RuleError(`Cannot extend type "Knwn" because it is not defined. Did you mean "Known"?`, 8, 19),
+	})
+}
+func TestValidate_PossibleTypeExtensions_ExtendingScalarAsObject(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.PossibleTypeExtensionsRule, `
+      scalar Foo
+      extend type Foo {
+        b: String
+      }
+      extend type String {
+        b: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot extend non-object type "Foo".`, 2, 14, 3, 19),
+		testutil.RuleError(`Cannot extend non-object type "String".`, 6, 19),
+	})
+}
+func TestValidate_PossibleTypeExtensions_ExtendingNonObjectOfSchema(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, testutil.TestSchema, graphql.PossibleTypeExtensionsRule, `
+      extend type Pet {
+        age: Int
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot extend non-object type "Pet".`, 2, 19),
+	})
+}
@@ -0,0 +1,60 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+var replacementFields = map[string]string{
+	"Dog.nickname": "name",
+}
+
+func TestValidate_PreferReplacementField_DeprecatedFieldSelected(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewPreferReplacementFieldRule(replacementFields), `
+      {
+        dog {
+          nickname
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "Dog.nickname" is deprecated, select "name" instead.`, 4, 11),
+	})
+}
+
+func TestValidate_PreferReplacementField_DeprecatedFieldSelectedThroughFragment(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewPreferReplacementFieldRule(replacementFields), `
+      {
+        pet {
+          ... on Dog {
+            name
+            nickname
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "Dog.nickname" is deprecated, select "name" instead.`, 6, 13),
+	})
+}
+
+func TestValidate_PreferReplacementField_ReplacementSelected(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewPreferReplacementFieldRule(replacementFields), `
+      {
+        dog {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
+
+func TestValidate_PreferReplacementField_SameFieldOnOtherType(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewPreferReplacementFieldRule(replacementFields), `
+      {
+        cat {
+          nickname
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
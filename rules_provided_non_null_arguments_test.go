@@ -175,3 +175,21 @@ func TestValidate_ProvidedNonNullArguments_DirectiveArguments_WithDirectiveWithM
 		testutil.RuleError(`Directive "@skip" argument "if" of type "Boolean!" is required but not provided.`, 4, 18),
 	})
 }
+func TestValidate_ProvidedNonNullArguments_DirectiveArguments_WithMissingConditionOnFragments(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.ProvidedNonNullArgumentsRule, `
+        {
+          dog {
+            ... on Dog @skip {
+              name
+            }
+            ...DogFields @include
+          }
+        }
+        fragment DogFields on Dog {
+          name
+        }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Directive "@skip" argument "if" of type "Boolean!" is required but not provided.`, 4, 24),
+		testutil.RuleError(`Directive "@include" argument "if" of type "Boolean!" is required but not provided.`, 7, 26),
+	})
+}
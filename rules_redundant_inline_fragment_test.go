@@ -0,0 +1,60 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_RedundantInlineFragment_WarnsOnSameTypeCondition(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRedundantInlineFragmentRule(), `
+      {
+        dog {
+          ... on Dog {
+            name
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Inline fragment on "Dog" is redundant as the selection is already of type "Dog". `+
+			`Consider removing the type condition.`, 4, 11),
+	})
+}
+
+func TestValidate_RedundantInlineFragment_NarrowingFragmentOnInterface(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRedundantInlineFragmentRule(), `
+      {
+        pet {
+          ... on Dog {
+            barkVolume
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
+
+func TestValidate_RedundantInlineFragment_WithoutTypeCondition(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRedundantInlineFragmentRule(), `
+      {
+        dog {
+          ... @include(if: true) {
+            name
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
+
+func TestValidate_RedundantInlineFragment_DoesNotInvalidateDocument(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewRedundantInlineFragmentRule(), `
+      {
+        dog {
+          ... on Dog {
+            name
+          }
+        }
+      }
+    `)
+}
@@ -0,0 +1,58 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_RequireDescriptions_DocumentedDefinitions(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireDescriptionsRule(), `
+      "A user."
+      type User {
+        "The name of the user."
+        name(
+          "Whether to include the surname."
+          surname: Boolean
+        ): String
+      }
+      extend type User {
+        "The age of the user."
+        age: Int
+      }
+    `, []gqlerrors.FormattedError{})
+}
+func TestValidate_RequireDescriptions_UndocumentedDefinitions(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireDescriptionsRule(), `
+      "A user."
+      type User {
+        "The name of the user."
+        name(surname: Boolean): String
+        age: Int
+      }
+      input UserFilter {
+        "The minimum age."
+        minAge: Int
+        name: String
+      }
+      directive @auth(role: String) on FIELD
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Argument "User.name(surname:)" has no description.`, 5, 14),
+		testutil.RuleError(`Field "User.age" has no description.`, 6, 9),
+		testutil.RuleError(`Type "UserFilter" has no description.`, 8, 13),
+		testutil.RuleError(`Field "UserFilter.name" has no description.`, 11, 9),
+		testutil.RuleError(`Argument "@auth(role:)" has no description.`, 13, 23),
+	})
+}
+func TestValidate_RequireDescriptions_OnlyGivenKinds(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireDescriptionsRule(kinds.FieldDefinition), `
+      type User {
+        name(surname: Boolean): String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "User.name" has no description.`, 3, 9),
+	})
+}
@@ -0,0 +1,48 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_RequireDirectiveOnTypes_WithDirectivePresent(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewRequireDirectiveOnTypesRule([]string{"Product"}, "key"), `
+      type Product @key(fields: "upc") {
+        upc: String!
+        name: String
+      }
+
+      type Review {
+        body: String
+      }
+    `)
+}
+
+func TestValidate_RequireDirectiveOnTypes_WithDirectiveOnExtension(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewRequireDirectiveOnTypesRule([]string{"Product"}, "key"), `
+      type Product {
+        upc: String!
+      }
+
+      extend type Product @key(fields: "upc") {
+        name: String
+      }
+    `)
+}
+
+func TestValidate_RequireDirectiveOnTypes_WithDirectiveAbsent(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewRequireDirectiveOnTypesRule([]string{"Product", "User"}, "key"), `
+      type Product @shareable {
+        upc: String!
+      }
+
+      type Review {
+        body: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Type "Product" must have the "@key" directive.`, 2, 7),
+	})
+}
@@ -0,0 +1,60 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_RequirePaginationArgs_WithFirst(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewRequirePaginationArgsRule([]string{"friends"}), `
+      {
+        human {
+          friends(first: 10) {
+            name
+          }
+        }
+      }
+    `)
+}
+func TestValidate_RequirePaginationArgs_WithLastAndBefore(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewRequirePaginationArgsRule([]string{"friends"}), `
+      {
+        human {
+          friends(last: 10, before: "cursor") {
+            name
+          }
+        }
+      }
+    `)
+}
+func TestValidate_RequirePaginationArgs_IgnoresOtherFields(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewRequirePaginationArgsRule([]string{"friends"}), `
+      {
+        human {
+          relatives {
+            name
+          }
+        }
+      }
+    `)
+}
+func TestValidate_RequirePaginationArgs_WithoutPaginationArgs(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.NewRequirePaginationArgsRule([]string{"friends"}), `
+      {
+        human {
+          friends {
+            name
+          }
+          other: friends(orderBy: NAME) {
+            name
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "friends" must be paginated with one of the "first", "after", "last" or "before" arguments.`, 4, 11),
+		testutil.RuleError(`Field "friends" must be paginated with one of the "first", "after", "last" or "before" arguments.`, 7, 11),
+	})
+}
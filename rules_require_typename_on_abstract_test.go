@@ -0,0 +1,78 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_RequireTypenameOnAbstract_InterfaceSelectionWithTypename(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireTypenameOnAbstractRule(), `
+      {
+        pet {
+          __typename
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
+
+func TestValidate_RequireTypenameOnAbstract_InterfaceSelectionWithoutTypename(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireTypenameOnAbstractRule(), `
+      {
+        pet {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Selection set on abstract type "Pet" should include "__typename".`, 3, 9),
+	})
+}
+
+func TestValidate_RequireTypenameOnAbstract_UnionSelectionWithoutTypename(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireTypenameOnAbstractRule(), `
+      {
+        catOrDog {
+          ... on Dog {
+            barkVolume
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Selection set on abstract type "CatOrDog" should include "__typename".`, 3, 9),
+	})
+}
+
+func TestValidate_RequireTypenameOnAbstract_FragmentOnAbstractTypeWithoutTypename(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireTypenameOnAbstractRule(), `
+      {
+        pet {
+          __typename
+          ...PetFields
+        }
+      }
+      fragment PetFields on Pet {
+        name
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Selection set on abstract type "Pet" should include "__typename".`, 8, 7),
+	})
+}
+
+func TestValidate_RequireTypenameOnAbstract_IgnoresObjectSelections(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewRequireTypenameOnAbstractRule(), `
+      {
+        dog {
+          name
+        }
+        catOrDog {
+          __typename
+          ... on Cat {
+            meows
+          }
+        }
+      }
+    `, []gqlerrors.FormattedError{})
+}
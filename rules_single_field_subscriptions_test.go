@@ -0,0 +1,101 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_SingleFieldSubscriptions_ValidSubscription(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.SingleFieldSubscriptionsRule, `
+      subscription ImportantEmails {
+        importantEmails
+      }
+    `)
+}
+
+func TestValidate_SingleFieldSubscriptions_FailsWithMoreThanOneRootField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SingleFieldSubscriptionsRule, `
+      subscription ImportantEmails {
+        importantEmails
+        notImportantEmails
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Subscription "ImportantEmails" must select only one top level field.`, 4, 9),
+	})
+}
+
+func TestValidate_SingleFieldSubscriptions_FailsWithManyMoreThanOneRootField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SingleFieldSubscriptionsRule, `
+      subscription {
+        importantEmails
+        notImportantEmails
+        spamEmails
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Anonymous Subscription must select only one top level field.`, 4, 9, 5, 9),
+	})
+}
+
+func TestValidate_SingleFieldSubscriptions_FailsWithTypenameRootField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SingleFieldSubscriptionsRule, `
+      subscription {
+        __typename
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Anonymous Subscription must not select an introspection top level field.`, 3, 9),
+	})
+}
+
+func TestValidate_SingleFieldSubscriptions_IgnoresQueries(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.SingleFieldSubscriptionsRule, `
+      query {
+        __typename
+        dog {
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_SingleFieldSubscriptions_ValidSubscriptionThroughFragment(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.SingleFieldSubscriptionsRule, `
+      subscription ImportantEmails {
+        ...A
+      }
+      fragment A on SubscriptionRoot {
+        importantEmails
+      }
+    `)
+}
+
+func TestValidate_SingleFieldSubscriptions_FailsWithRootFieldsThroughFragments(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SingleFieldSubscriptionsRule, `
+      subscription ImportantEmails {
+        ...A
+        ...B
+      }
+      fragment A on SubscriptionRoot {
+        importantEmails
+      }
+      fragment B on SubscriptionRoot {
+        notImportantEmails
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Subscription "ImportantEmails" must select only one top level field.`, 10, 9),
+	})
+}
+
+func TestValidate_SingleFieldSubscriptions_FailsWithTypenameRootFieldThroughInlineFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SingleFieldSubscriptionsRule, `
+      subscription {
+        ... {
+          __typename
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Anonymous Subscription must not select an introspection top level field.`, 4, 11),
+	})
+}
@@ -0,0 +1,75 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_SkipIncludeConflict_SingleDirective(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewSkipIncludeConflictRule(), `
+      {
+        dog {
+          name @skip(if: true)
+          nickname @include(if: false)
+        }
+      }
+    `)
+}
+func TestValidate_SkipIncludeConflict_AgreeingConstants(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewSkipIncludeConflictRule(), `
+      {
+        dog {
+          name @skip(if: false) @include(if: true)
+          nickname @skip(if: true) @include(if: false)
+        }
+      }
+    `)
+}
+func TestValidate_SkipIncludeConflict_VariableConditions(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.NewSkipIncludeConflictRule(), `
+      query Foo($skip: Boolean!, $include: Boolean!) {
+        dog {
+          name @skip(if: $skip) @include(if: $include)
+          nickname @skip(if: true) @include(if: $include)
+        }
+      }
+    `)
+}
+func TestValidate_SkipIncludeConflict_ContradictoryConstants(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewSkipIncludeConflictRule(), `
+      {
+        dog {
+          name @skip(if: true) @include(if: true)
+          nickname @include(if: false) @skip(if: false)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Directives "@skip(if: true)" and "@include(if: true)" contradict each other, `+
+			`so the selection is never included.`, 4, 16, 4, 32),
+		testutil.RuleError(`Directives "@skip(if: false)" and "@include(if: false)" contradict each other, `+
+			`so the selection is never included.`, 5, 40, 5, 20),
+	})
+}
+func TestValidate_SkipIncludeConflict_ContradictoryConstantsOnFragments(t *testing.T) {
+	testutil.ExpectWarnsRule(t, graphql.NewSkipIncludeConflictRule(), `
+      {
+        dog {
+          ... on Dog @skip(if: true) @include(if: true) {
+            name
+          }
+          ...DogFields @skip(if: false) @include(if: false)
+        }
+      }
+      fragment DogFields on Dog {
+        nickname
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Directives "@skip(if: true)" and "@include(if: true)" contradict each other, `+
+			`so the selection is never included.`, 4, 22, 4, 38),
+		testutil.RuleError(`Directives "@skip(if: false)" and "@include(if: false)" contradict each other, `+
+			`so the selection is never included.`, 7, 24, 7, 41),
+	})
+}
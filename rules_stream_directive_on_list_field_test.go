@@ -0,0 +1,138 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func incrementalDeliverySchema(t *testing.T) *graphql.Schema {
+	return incrementalDeliverySchemaWithDirectives(t, graphql.IncrementalDeliveryDirectives...)
+}
+
+// customIncrementalDeliverySchema The schema of incrementalDeliverySchema, but
+// with its own @defer and @stream directives, which don't have the semantics
+// of the built-in ones.
+func customIncrementalDeliverySchema(t *testing.T) *graphql.Schema {
+	return incrementalDeliverySchemaWithDirectives(t,
+		graphql.NewDirective(graphql.DirectiveConfig{
+			Name:      "defer",
+			Locations: []string{graphql.DirectiveLocationField, graphql.DirectiveLocationFragmentSpread, graphql.DirectiveLocationInlineFragment},
+		}),
+		graphql.NewDirective(graphql.DirectiveConfig{
+			Name: "stream",
+			Args: graphql.FieldConfigArgument{
+				"initialCount": &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Locations: []string{graphql.DirectiveLocationField},
+		}),
+	)
+}
+
+func incrementalDeliverySchemaWithDirectives(t *testing.T, incrementalDeliveryDirectives ...*graphql.Directive) *graphql.Schema {
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+		},
+	})
+	directives := []*graphql.Directive{}
+	directives = append(directives, graphql.SpecifiedDirectives...)
+	directives = append(directives, incrementalDeliveryDirectives...)
+	mutationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"addItem": &graphql.Field{Type: itemType},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"items": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(itemType))},
+				"item":  &graphql.Field{Type: itemType},
+				// The mutation type is also reachable from queries.
+				"mutations": &graphql.Field{Type: mutationType},
+			},
+		}),
+		Mutation: mutationType,
+		Subscription: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Subscription",
+			Fields: graphql.Fields{
+				"itemAdded": &graphql.Field{Type: itemType},
+			},
+		}),
+		Directives: directives,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return &schema
+}
+
+func TestValidate_StreamDirectiveOnListField_DeferOnFragmentSpread(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, incrementalDeliverySchema(t), graphql.KnownDirectivesRule, `
+      {
+        item {
+          ...ItemFields @defer(label: "fields")
+        }
+      }
+      fragment ItemFields on Item {
+        name
+      }
+    `)
+}
+
+func TestValidate_StreamDirectiveOnListField_StreamOnListField(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, incrementalDeliverySchema(t), graphql.StreamDirectiveOnListFieldRule, `
+      {
+        items @stream(initialCount: 2) {
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_StreamDirectiveOnListField_StreamOnNonListField(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, incrementalDeliverySchema(t), graphql.StreamDirectiveOnListFieldRule, `
+      {
+        item @stream {
+          name @stream
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Directive "@stream" cannot be used on non-list field "Query.item".`, 3, 14),
+		testutil.RuleError(`Directive "@stream" cannot be used on non-list field "Item.name".`, 4, 16),
+	})
+}
+
+func TestValidate_StreamDirectiveOnListField_IgnoredWithoutIncrementalDelivery(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.StreamDirectiveOnListFieldRule, `
+      {
+        dog @stream {
+          name
+        }
+      }
+    `)
+	testutil.ExpectFailsRule(t, graphql.KnownDirectivesRule, `
+      {
+        dog @stream {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Unknown directive "stream".`, 3, 13),
+	})
+}
+
+func TestValidate_StreamDirectiveOnListField_IgnoredWithCustomStream(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, customIncrementalDeliverySchema(t), graphql.StreamDirectiveOnListFieldRule, `
+      {
+        item @stream {
+          name
+        }
+      }
+    `)
+}
@@ -0,0 +1,51 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_StreamInitialCount_NegativeLiteral(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, incrementalDeliverySchema(t), graphql.StreamInitialCountRule, `
+      {
+        items @stream(initialCount: -1) {
+          name
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`@stream initialCount must not be negative.`, 3, 37),
+	})
+}
+
+func TestValidate_StreamInitialCount_ValidLiteral(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, incrementalDeliverySchema(t), graphql.StreamInitialCountRule, `
+      {
+        items @stream(initialCount: 0) {
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_StreamInitialCount_Variable(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, incrementalDeliverySchema(t), graphql.StreamInitialCountRule, `
+      query Q($count: Int) {
+        items @stream(initialCount: $count) {
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_StreamInitialCount_IgnoredWithCustomStream(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, customIncrementalDeliverySchema(t), graphql.StreamInitialCountRule, `
+      {
+        items @stream(initialCount: -1) {
+          name
+        }
+      }
+    `)
+}
@@ -0,0 +1,96 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_SubscriptionRootFieldUnconditional_PlainRootField(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      subscription ImportantEmails {
+        importantEmails
+      }
+    `)
+}
+
+func TestValidate_SubscriptionRootFieldUnconditional_RootFieldWithSkip(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      subscription ImportantEmails($skip: Boolean!) {
+        importantEmails @skip(if: $skip)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Subscription "ImportantEmails" must not use "@skip" on its root field.`, 3, 25),
+	})
+}
+
+func TestValidate_SubscriptionRootFieldUnconditional_AnonymousRootFieldWithInclude(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      subscription {
+        importantEmails @include(if: true)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Anonymous Subscription must not use "@include" on its root field.`, 3, 25),
+	})
+}
+
+func TestValidate_SubscriptionRootFieldUnconditional_NestedFieldsMayUseDirectives(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      subscription ImportantEmails($skip: Boolean!) {
+        importantEmails {
+          subject @skip(if: $skip)
+          body @include(if: true)
+        }
+      }
+    `)
+}
+
+func TestValidate_SubscriptionRootFieldUnconditional_IgnoresQueries(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      query Q($skip: Boolean!) {
+        dog @skip(if: $skip) {
+          name
+        }
+      }
+    `)
+}
+
+func TestValidate_SubscriptionRootFieldUnconditional_ConditionalInlineFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      subscription ImportantEmails($c: Boolean!) {
+        ... @include(if: $c) {
+          importantEmails
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Subscription "ImportantEmails" must not use "@include" on its root field.`, 3, 13),
+	})
+}
+
+func TestValidate_SubscriptionRootFieldUnconditional_ConditionalFragmentSpread(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      subscription ImportantEmails($c: Boolean!) {
+        ...Emails @skip(if: $c)
+      }
+      fragment Emails on SubscriptionRoot {
+        importantEmails
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Subscription "ImportantEmails" must not use "@skip" on its root field.`, 3, 19),
+	})
+}
+
+func TestValidate_SubscriptionRootFieldUnconditional_ConditionalRootFieldInSpreadFragment(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.SubscriptionRootFieldUnconditionalRule, `
+      subscription ImportantEmails($c: Boolean!) {
+        ...Emails
+      }
+      fragment Emails on SubscriptionRoot {
+        importantEmails @include(if: $c)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Subscription "ImportantEmails" must not use "@include" on its root field.`, 6, 25),
+	})
+}
@@ -0,0 +1,51 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_UniqueDirectiveNames_OneDirective(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueDirectiveNamesRule, `
+      directive @foo on FIELD
+    `)
+}
+func TestValidate_UniqueDirectiveNames_ManyDirectives(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueDirectiveNamesRule, `
+      directive @foo on FIELD
+      directive @bar on FIELD
+      directive @baz on FIELD
+    `)
+}
+func TestValidate_UniqueDirectiveNames_DirectiveAndTypeWithSameName(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueDirectiveNamesRule, `
+      directive @foo on FIELD
+      scalar foo
+    `)
+}
+func TestValidate_UniqueDirectiveNames_DuplicateDirectives(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.UniqueDirectiveNamesRule, `
+      directive @foo on FIELD
+      directive @foo on FRAGMENT_SPREAD
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`There can be only one directive named "@foo".`, 2, 18, 3, 18),
+	})
+}
+func TestValidate_UniqueDirectiveNames_SpecifiedDirective(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.UniqueDirectiveNamesRule, `
+      directive @skip on FIELD
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Directive "@skip" already exists in the schema. It cannot be redefined.`, 2, 18),
+	})
+}
+func TestValidate_UniqueDirectiveNames_DirectiveOfExtendedSchema(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, testutil.TestSchema, graphql.UniqueDirectiveNamesRule, `
+      directive @onQuery on QUERY
+      directive @onRequest on QUERY
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Directive "@onQuery" already exists in the schema. It cannot be redefined.`, 2, 18),
+	})
+}
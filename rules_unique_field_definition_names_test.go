@@ -0,0 +1,84 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_UniqueFieldDefinitionNames_UniqueFields(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueFieldDefinitionNamesRule, `
+      type SomeObject {
+        foo: String
+        bar: String
+      }
+      interface SomeInterface {
+        foo: String
+        bar: String
+      }
+      input SomeInputObject {
+        foo: String
+        bar: String
+      }
+    `)
+}
+func TestValidate_UniqueFieldDefinitionNames_SameFieldOnDifferentTypes(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueFieldDefinitionNamesRule, `
+      type SomeObject {
+        foo: String
+      }
+      type OtherObject {
+        foo: String
+      }
+    `)
+}
+func TestValidate_UniqueFieldDefinitionNames_ExtensionAddingNewField(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueFieldDefinitionNamesRule, `
+      type SomeObject {
+        foo: String
+      }
+      extend type SomeObject {
+        bar: String
+      }
+    `)
+}
+func TestValidate_UniqueFieldDefinitionNames_DuplicateFieldsInsideDefinition(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.UniqueFieldDefinitionNamesRule, `
+      type SomeObject {
+        foo: String
+        bar: String
+        foo: String
+      }
+      input SomeInputObject {
+        foo: String
+        foo: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "SomeObject.foo" can only be defined once.`, 3, 9, 5, 9),
+		testutil.RuleError(`Field "SomeInputObject.foo" can only be defined once.`, 8, 9, 9, 9),
+	})
+}
+func TestValidate_UniqueFieldDefinitionNames_ExtensionRedefiningField(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.UniqueFieldDefinitionNamesRule, `
+      type SomeObject {
+        foo: String
+      }
+      extend type SomeObject {
+        foo: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "SomeObject.foo" can only be defined once.`, 3, 9, 6, 9),
+	})
+}
+func TestValidate_UniqueFieldDefinitionNames_ExtensionRedefiningFieldOfSchema(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, testutil.TestSchema, graphql.UniqueFieldDefinitionNamesRule, `
+      extend type Dog {
+        name: String
+        age: Int
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "Dog.name" already exists in the schema. It cannot also be defined in this type extension.`, 3, 9),
+	})
+}
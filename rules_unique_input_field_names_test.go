@@ -63,3 +63,14 @@ func TestValidate_UniqueInputFieldNames_ManyDuplicateInputObjectFields(t *testin
 		testutil.RuleError(`There can be only one input field named "f1".`, 3, 22, 3, 48),
 	})
 }
+
+func TestValidate_UniqueInputFieldNames_ManyDuplicateNestedInputObjectFields(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueInputFieldNamesRule, `
+      {
+        field(arg: { f1: { f2: "value", f3: "value", f2: "value", f2: "value" } })
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`There can be only one input field named "f2".`, 3, 28, 3, 54),
+		testutil.RuleError(`There can be only one input field named "f2".`, 3, 28, 3, 67),
+	})
+}
@@ -0,0 +1,119 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_UniqueTypeNames_NoTypes(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueTypeNamesRule, `
+      directive @test on FIELD
+    `)
+}
+func TestValidate_UniqueTypeNames_OneType(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueTypeNamesRule, `
+      type Foo {
+        a: String
+      }
+    `)
+}
+func TestValidate_UniqueTypeNames_ManyTypes(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueTypeNamesRule, `
+      type Foo {
+        a: String
+      }
+      type Bar {
+        a: String
+      }
+      scalar Baz
+    `)
+}
+func TestValidate_UniqueTypeNames_TypeAndItsExtension(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.UniqueTypeNamesRule, `
+      type Foo {
+        a: String
+      }
+      extend type Foo {
+        b: String
+      }
+    `)
+}
+func TestValidate_UniqueTypeNames_DuplicateTypes(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.UniqueTypeNamesRule, `
+      type Foo {
+        a: String
+      }
+      scalar Foo
+      type Foo {
+        b: String
+      }
+      interface Foo {
+        a: String
+      }
+      union Foo = Bar
+      enum Foo { A }
+      input Foo {
+        a: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`There can be only one type named "Foo".`, 2, 12, 5, 14),
+		testutil.RuleError(`There can be only one type named "Foo".`, 2, 12, 6, 12),
+		testutil.RuleError(`There can be only one type named "Foo".`, 2, 12, 9, 17),
+		testutil.RuleError(`There can be only one type named "Foo".`, 2, 12, 12, 13),
+		testutil.RuleError(`There can be only one type named "Foo".`, 2, 12, 13, 12),
+		testutil.RuleError(`There can be only one type named "Foo".`, 2, 12, 14, 13),
+	})
+}
+
+func TestValidateSDL_ReportsDuplicateTypes(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      type Query {
+        foo: Foo
+      }
+      type Foo {
+        a: String
+      }
+      type Foo {
+        b: String
+      }
+    `)
+	result := graphql.ValidateSDL(doc)
+	if result.IsValid {
+		t.Fatalf("Expected an invalid result")
+	}
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`There can be only one type named "Foo".`, 5, 12, 8, 12),
+	}
+	if len(result.Errors) != 1 || !testutil.EqualFormattedError(expected[0], result.Errors[0]) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
+func TestValidate_UniqueTypeNames_DefinitionCollidingWithBuiltInType(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.UniqueTypeNamesRule, `
+      scalar String
+      type __Schema {
+        a: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Type "String" already exists in the schema. It cannot also be defined in this type definition.`, 2, 14),
+		testutil.RuleError(`Type "__Schema" already exists in the schema. It cannot also be defined in this type definition.`, 3, 12),
+	})
+}
+func TestValidate_UniqueTypeNames_DefinitionCollidingWithSchemaType(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, testutil.TestSchema, graphql.UniqueTypeNamesRule, `
+      type Dog {
+        name: String
+      }
+      type Cow {
+        name: String
+      }
+      extend type Human {
+        age: Int
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Type "Dog" already exists in the schema. It cannot also be defined in this type definition.`, 2, 12),
+	})
+}
@@ -0,0 +1,77 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestValidate_ValidSchemaDefinition_SchemaDefinition(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, nil, graphql.ValidSchemaDefinitionRule, `
+      type Query {
+        a: String
+      }
+      type Mutation {
+        a: String
+      }
+      schema {
+        query: Query
+        mutation: Mutation
+      }
+    `)
+}
+func TestValidate_ValidSchemaDefinition_ExtendingSchemaWithMutationType(t *testing.T) {
+	testutil.ExpectPassesSDLRule(t, testutil.TestSchema, graphql.ValidSchemaDefinitionRule, `
+      type Mutation {
+        a: String
+      }
+      extend schema {
+        mutation: Mutation
+      }
+    `)
+}
+func TestValidate_ValidSchemaDefinition_ExtendingSchemaWithScalar(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, testutil.TestSchema, graphql.ValidSchemaDefinitionRule, `
+      scalar Mutation
+      extend schema {
+        mutation: Mutation
+        subscription: String
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Mutation root type must be Object type, it cannot be Mutation.`, 4, 19),
+		testutil.RuleError(`Subscription root type must be Object type, it cannot be String.`, 5, 23),
+	})
+}
+func TestValidate_ValidSchemaDefinition_RedefiningRootTypeOfSchema(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, testutil.TestSchema, graphql.ValidSchemaDefinitionRule, `
+      type Query {
+        a: String
+      }
+      extend schema {
+        query: Query
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Type for query already defined in the schema. It cannot be redefined.`, 6, 9),
+	})
+}
+func TestValidate_ValidSchemaDefinition_RedefiningRootTypeInDocument(t *testing.T) {
+	testutil.ExpectFailsSDLRule(t, nil, graphql.ValidSchemaDefinitionRule, `
+      type Query {
+        a: String
+      }
+      type Mutation {
+        a: String
+      }
+      schema {
+        query: Query
+        mutation: Mutation
+      }
+      extend schema {
+        mutation: Mutation
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`There can be only one mutation type in schema.`, 13, 9),
+	})
+}
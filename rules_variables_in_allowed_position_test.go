@@ -1,10 +1,12 @@
 package graphql_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -244,3 +246,172 @@ func TestValidate_VariablesInAllowedPosition_StringToNonNullableBooleanInDirecti
 			`expecting type "Boolean!".`, 2, 19, 3, 26),
 	})
 }
+
+func TestValidate_VariablesInAllowedPosition_ListOfIntToListOfInt(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($intListVar: [Int]) {
+        complicatedArgs {
+          intListArgField(intListArg: $intListVar)
+        }
+      }
+    `)
+}
+func TestValidate_VariablesInAllowedPosition_IntToListOfInt(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($intVar: Int) {
+        complicatedArgs {
+          intListArgField(intListArg: $intVar)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$intVar" of type "Int" used in position `+
+			`expecting type "[Int]".`, 2, 19, 4, 39),
+	})
+}
+func TestValidate_VariablesInAllowedPosition_ListOfIntToInt(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($intListVar: [Int]) {
+        complicatedArgs {
+          intArgField(intArg: $intListVar)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$intListVar" of type "[Int]" used in position `+
+			`expecting type "Int".`, 2, 19, 4, 31),
+	})
+}
+func TestValidate_VariablesInAllowedPosition_ListOfIntWithDefaultToInt(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($intListVar: [Int] = [1]) {
+        complicatedArgs {
+          intArgField(intArg: $intListVar)
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$intListVar" of type "[Int]" used in position `+
+			`expecting type "Int".`, 2, 19, 4, 31),
+	})
+}
+func TestValidate_VariablesInAllowedPosition_IntInListOfStringLiteral(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($intVar: Int) {
+        complicatedArgs {
+          stringListArgField(stringListArg: ["one", $intVar])
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$intVar" of type "Int" used in position `+
+			`expecting type "String".`, 2, 19, 4, 53),
+	})
+}
+func TestValidate_VariablesInAllowedPosition_StringInListOfStringLiteral(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($stringVar: String!) {
+        complicatedArgs {
+          stringListArgField(stringListArg: ["one", $stringVar])
+        }
+      }
+    `)
+}
+func TestValidate_VariablesInAllowedPosition_IntInInputObjectLiteral(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($intVar: Int, $boolVar: Boolean) {
+        complicatedArgs {
+          complexArgField(complexArg: {requiredField: $boolVar, stringField: $intVar})
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$boolVar" of type "Boolean" used in position `+
+			`expecting type "Boolean!".`, 2, 33, 4, 55),
+		testutil.RuleError(`Variable "$intVar" of type "Int" used in position `+
+			`expecting type "String".`, 2, 19, 4, 78),
+	})
+}
+func TestValidate_VariablesInAllowedPosition_ListInInputObjectLiteral(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.VariablesInAllowedPositionRule, `
+      query Query($stringVar: String, $intVar: Int) {
+        complicatedArgs {
+          complexArgField(complexArg: {
+            requiredField: true,
+            stringListField: [$stringVar, $intVar]
+          })
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$intVar" of type "Int" used in position `+
+			`expecting type "String".`, 2, 39, 6, 43),
+	})
+}
+
+func TestEffectiveType_DefaultedNullableVariableIsNonNull(t *testing.T) {
+	doc := testutil.TestParse(t, `query Query($intVar: Int = 1) { dog { name } }`)
+	varDef := doc.Definitions[0].(*ast.OperationDefinition).VariableDefinitions[0]
+	ttype := graphql.EffectiveType(graphql.Int, varDef)
+	if nonNull, ok := ttype.(*graphql.NonNull); !ok || nonNull.OfType != graphql.Int {
+		t.Fatalf("Expected Int!, got: %v", ttype)
+	}
+}
+func TestEffectiveType_NonNullVariableIsUnchanged(t *testing.T) {
+	doc := testutil.TestParse(t, `query Query($intVar: Int! = 1, $other: Int!) { dog { name } }`)
+	nonNullInt := graphql.NewNonNull(graphql.Int)
+	for _, varDef := range doc.Definitions[0].(*ast.OperationDefinition).VariableDefinitions {
+		if ttype := graphql.EffectiveType(nonNullInt, varDef); ttype != nonNullInt {
+			t.Fatalf("Expected the Int! type unchanged, got: %v", ttype)
+		}
+	}
+}
+func TestEffectiveType_NullableVariableWithoutDefaultIsUnchanged(t *testing.T) {
+	doc := testutil.TestParse(t, `query Query($intVar: Int) { dog { name } }`)
+	varDef := doc.Definitions[0].(*ast.OperationDefinition).VariableDefinitions[0]
+	if ttype := graphql.EffectiveType(graphql.Int, varDef); ttype != graphql.Int {
+		t.Fatalf("Expected Int, got: %v", ttype)
+	}
+}
+func TestRequiredVariables_MixOfRequiredOptionalAndDefaultedVariables(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      query Query($required: Int!, $optional: String, $defaulted: Int! = 1, $defaultedNullable: Boolean = true) {
+        dog { name }
+      }
+    `)
+	typeInfo := graphql.NewTypeInfo(&graphql.TypeInfoConfig{
+		Schema: testutil.TestSchema,
+	})
+	context := graphql.NewValidationContext(testutil.TestSchema, doc, typeInfo)
+	vars := graphql.RequiredVariables(context, doc.Definitions[0].(*ast.OperationDefinition))
+
+	expected := []struct {
+		name     string
+		ttype    string
+		required bool
+	}{
+		{"required", "Int!", true},
+		{"optional", "String", false},
+		{"defaulted", "Int!", false},
+		{"defaultedNullable", "Boolean", false},
+	}
+	if len(vars) != len(expected) {
+		t.Fatalf("Expected %v variables, got: %v", len(expected), vars)
+	}
+	for i, e := range expected {
+		if vars[i].Name != e.name || vars[i].Type.String() != e.ttype || vars[i].Required != e.required {
+			t.Fatalf("Expected variable %v %v (required: %v), got: %+v", e.name, e.ttype, e.required, vars[i])
+		}
+	}
+}
+func TestRequiredVariables_WithoutSchema(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      query Query($required: Int!, $optional: String) {
+        dog { name }
+      }
+    `)
+	context := graphql.NewValidationContext(nil, doc, nil)
+	vars := graphql.RequiredVariables(context, doc.Definitions[0].(*ast.OperationDefinition))
+
+	expected := []graphql.RequiredVar{
+		{Name: "required", Type: nil, Required: true},
+		{Name: "optional", Type: nil, Required: false},
+	}
+	if !reflect.DeepEqual(vars, expected) {
+		t.Fatalf("Expected %+v, got: %+v", expected, vars)
+	}
+}
@@ -52,3 +52,34 @@ func TestTypeSystem_Scalar_ParseLiteralOutputDateTime(t *testing.T) {
 		})
 	}
 }
+
+func TestTypeSystem_Scalar_ParseLiteralIntIntoFloat(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		Literal  ast.Value
+		Expected interface{}
+	}{
+		"SmallInt": {
+			Literal:  &ast.IntValue{Value: "3"},
+			Expected: float64(3),
+		},
+		"IntBeyondFloat32Precision": {
+			Literal:  &ast.IntValue{Value: "16777217"},
+			Expected: float64(16777217),
+		},
+		"Float": {
+			Literal:  &ast.FloatValue{Value: "3.5"},
+			Expected: float64(3.5),
+		},
+		"NotANumber": {
+			Literal:  &ast.StringValue{Value: "3"},
+			Expected: nil,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			parsed := graphql.Float.ParseLiteral(testCase.Literal)
+			if parsed != testCase.Expected {
+				t.Fatalf("failed Float.ParseLiteral(%T(%v)), expected: %v, got %v", testCase.Literal, testCase.Literal, testCase.Expected, parsed)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"fmt"
+	"sort"
 )
 
 type SchemaConfig struct {
@@ -112,6 +113,21 @@ func NewSchema(config SchemaConfig) (Schema, error) {
 
 	schema.typeMap = typeMap
 
+	// Enforce well-formed union members, in type name order so that the
+	// same error is reported when several unions are invalid.
+	typeNames := make([]string, 0, len(schema.typeMap))
+	for typeName := range schema.typeMap {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	for _, typeName := range typeNames {
+		if ttype, ok := schema.typeMap[typeName].(*Union); ok {
+			if err := ValidateUnion(ttype); err != nil {
+				return schema, err
+			}
+		}
+	}
+
 	// Keep track of all implementations by interface name.
 	if schema.implementations == nil {
 		schema.implementations = map[string][]*Object{}
@@ -0,0 +1,137 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidateInputObjectCycles Validates the input object types of the schema
+// for references to themselves through a chain of non-null fields.
+//
+// Input objects may reference themselves, e.g. to build a tree, as long as
+// the chain contains a nullable or list field to end it; a chain of non-null
+// fields only can never be satisfied by a finite value.
+func ValidateInputObjectCycles(schema *Schema) []error {
+	if schema == nil {
+		return nil
+	}
+	typeMap := schema.TypeMap()
+	typeNames := []string{}
+	for name := range typeMap {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+
+	// Input objects already checked, cycles through them were reported then.
+	visited := map[string]bool{}
+	// The chain of non-null fields leading to the current input object, and
+	// for each input object of the chain the index of its field in it.
+	fieldPath := []string{}
+	fieldPathIndexByTypeName := map[string]int{}
+
+	errs := []error{}
+	var detectCycle func(inputObj *InputObject)
+	detectCycle = func(inputObj *InputObject) {
+		if visited[inputObj.Name()] {
+			return
+		}
+		visited[inputObj.Name()] = true
+		fieldPathIndexByTypeName[inputObj.Name()] = len(fieldPath)
+
+		fields := inputObj.Fields()
+		fieldNames := []string{}
+		for name := range fields {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+
+		for _, fieldName := range fieldNames {
+			nonNull, ok := fields[fieldName].Type.(*NonNull)
+			if !ok {
+				continue
+			}
+			fieldType, ok := nonNull.OfType.(*InputObject)
+			if !ok {
+				continue
+			}
+			fieldPath = append(fieldPath, fmt.Sprintf("%v.%v", inputObj.Name(), fieldName))
+			if cycleIndex, ok := fieldPathIndexByTypeName[fieldType.Name()]; !ok {
+				detectCycle(fieldType)
+			} else {
+				chain := append(append([]string{}, fieldPath[cycleIndex:]...), fieldType.Name())
+				errs = append(errs, invariantf(false,
+					`Cannot reference Input Object "%v" within itself through a chain of non-null fields: %v.`,
+					fieldType.Name(), strings.Join(chain, " -> ")))
+			}
+			fieldPath = fieldPath[:len(fieldPath)-1]
+		}
+		delete(fieldPathIndexByTypeName, inputObj.Name())
+	}
+
+	for _, name := range typeNames {
+		if inputObj, ok := typeMap[name].(*InputObject); ok {
+			detectCycle(inputObj)
+		}
+	}
+	return errs
+}
+
+// ValidateDirectiveArguments Validates the arguments of the directives of the
+// schema, whose types must be input types.
+func ValidateDirectiveArguments(schema *Schema) []error {
+	if schema == nil {
+		return nil
+	}
+	errs := []error{}
+	for _, directive := range schema.Directives() {
+		if directive == nil {
+			continue
+		}
+		// The arguments come from a map, sort them for stable errors.
+		args := append([]*Argument{}, directive.Args...)
+		sort.Slice(args, func(i, j int) bool {
+			return args[i].Name() < args[j].Name()
+		})
+		for _, arg := range args {
+			if arg.Type == nil || IsInputType(arg.Type) {
+				continue
+			}
+			errs = append(errs, invariantf(false,
+				`The type of @%v(%v:) must be Input Type but got: %v.`,
+				directive.Name, arg.Name(), arg.Type))
+		}
+	}
+	return errs
+}
+
+// ValidateUnion Validates the members of a union type: each must be an Object
+// type, listed only once.
+//
+// Types can't overlap with a union listing a member twice the way they do
+// with a well-formed one, so NewSchema rejects such unions.
+func ValidateUnion(u *Union) error {
+	if u == nil {
+		return nil
+	}
+	if err := u.Error(); err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	for _, member := range u.Types() {
+		if err := invariantf(
+			member != nil,
+			`%v may only contain Object types, it cannot contain: %v.`, u, member,
+		); err != nil {
+			return err
+		}
+		if err := invariantf(
+			!seen[member.Name()],
+			`Union %v can only include type %v once.`, u, member,
+		); err != nil {
+			return err
+		}
+		seen[member.Name()] = true
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+)
+
+func schemaWithInputObjects(t *testing.T, inputs ...*graphql.InputObject) *graphql.Schema {
+	args := graphql.FieldConfigArgument{}
+	for _, input := range inputs {
+		args[input.Name()] = &graphql.ArgumentConfig{Type: input}
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{
+					Type: graphql.String,
+					Args: args,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating schema: %v", err)
+	}
+	return &schema
+}
+
+func TestValidateInputObjectCycles_RejectsNonNullCycle(t *testing.T) {
+	var x, y *graphql.InputObject
+	x = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "X",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			return graphql.InputObjectConfigFieldMap{
+				"a": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(y)},
+			}
+		}),
+	})
+	y = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Y",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			return graphql.InputObjectConfigFieldMap{
+				"b": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(x)},
+			}
+		}),
+	})
+	errs := graphql.ValidateInputObjectCycles(schemaWithInputObjects(t, x))
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	expected := []string{
+		`Cannot reference Input Object "X" within itself through a chain of non-null fields: X.a -> Y.b -> X.`,
+	}
+	if !reflect.DeepEqual(expected, messages) {
+		t.Fatalf("unexpected errors, expected: %v, got: %v", expected, messages)
+	}
+}
+
+func TestValidateInputObjectCycles_RejectsNonNullSelfReference(t *testing.T) {
+	var x *graphql.InputObject
+	x = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "X",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			return graphql.InputObjectConfigFieldMap{
+				"self": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(x)},
+			}
+		}),
+	})
+	errs := graphql.ValidateInputObjectCycles(schemaWithInputObjects(t, x))
+	if len(errs) != 1 {
+		t.Fatalf("expected one error, got: %v", errs)
+	}
+	expected := `Cannot reference Input Object "X" within itself through a chain of non-null fields: X.self -> X.`
+	if errs[0].Error() != expected {
+		t.Fatalf("unexpected error, expected: %v, got: %v", expected, errs[0])
+	}
+}
+
+func TestValidateInputObjectCycles_AcceptsCycleBrokenByNullableField(t *testing.T) {
+	var x, y *graphql.InputObject
+	x = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "X",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			return graphql.InputObjectConfigFieldMap{
+				"a": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(y)},
+			}
+		}),
+	})
+	y = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Y",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			return graphql.InputObjectConfigFieldMap{
+				"b":    &graphql.InputObjectFieldConfig{Type: x},
+				"list": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(x)))},
+			}
+		}),
+	})
+	if errs := graphql.ValidateInputObjectCycles(schemaWithInputObjects(t, x)); len(errs) != 0 {
+		t.Fatalf("expected no errors, got: %v", errs)
+	}
+}
+
+func unionMemberObject(name string) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: name,
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+		},
+	})
+}
+
+func unionResolveType(p graphql.ResolveTypeParams) *graphql.Object {
+	return nil
+}
+
+func TestValidateUnion_AcceptsDistinctObjectMembers(t *testing.T) {
+	union := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "Pet",
+		Types:       []*graphql.Object{unionMemberObject("Dog"), unionMemberObject("Cat")},
+		ResolveType: unionResolveType,
+	})
+	if err := graphql.ValidateUnion(union); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateUnion_RejectsDuplicateMember(t *testing.T) {
+	dog := unionMemberObject("Dog")
+	union := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "Pet",
+		Types:       []*graphql.Object{dog, unionMemberObject("Cat"), dog},
+		ResolveType: unionResolveType,
+	})
+	expected := `Union Pet can only include type Dog once.`
+	if err := graphql.ValidateUnion(union); err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got: %v", expected, err)
+	}
+
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pet": &graphql.Field{Type: union},
+			},
+		}),
+	})
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected NewSchema to fail with %q, got: %v", expected, err)
+	}
+}
+
+func TestNewSchema_ReportsFirstInvalidUnionByName(t *testing.T) {
+	dog := unionMemberObject("Dog")
+	cat := unionMemberObject("Cat")
+	pet := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "Pet",
+		Types:       []*graphql.Object{dog, dog},
+		ResolveType: unionResolveType,
+	})
+	animal := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "Animal",
+		Types:       []*graphql.Object{cat, cat},
+		ResolveType: unionResolveType,
+	})
+	expected := `Union Animal can only include type Cat once.`
+	for i := 0; i < 20; i++ {
+		_, err := graphql.NewSchema(graphql.SchemaConfig{
+			Query: graphql.NewObject(graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"pet":    &graphql.Field{Type: pet},
+					"animal": &graphql.Field{Type: animal},
+				},
+			}),
+		})
+		if err == nil || err.Error() != expected {
+			t.Fatalf("expected NewSchema to fail with %q, got: %v", expected, err)
+		}
+	}
+}
+
+// UnionConfig.Types only holds Object types, so a non-object member can only
+// be a nil one, e.g. an *Object variable which isn't assigned yet.
+func TestValidateUnion_RejectsNonObjectMember(t *testing.T) {
+	var cat *graphql.Object
+	union := graphql.NewUnion(graphql.UnionConfig{
+		Name:        "Pet",
+		Types:       []*graphql.Object{unionMemberObject("Dog"), cat},
+		ResolveType: unionResolveType,
+	})
+	expected := `Pet may only contain Object types, it cannot contain: <nil>.`
+	if err := graphql.ValidateUnion(union); err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got: %v", expected, err)
+	}
+}
+
+func schemaWithDirective(t *testing.T, directive *graphql.Directive) *graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{Type: graphql.String},
+			},
+		}),
+		Directives: append(append([]*graphql.Directive{}, graphql.SpecifiedDirectives...), directive),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating schema: %v", err)
+	}
+	return &schema
+}
+
+func TestValidateDirectiveArguments_RejectsObjectArgument(t *testing.T) {
+	schema := schemaWithDirective(t, graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "owner",
+		Locations: []string{graphql.DirectiveLocationField},
+		Args: graphql.FieldConfigArgument{
+			"user": &graphql.ArgumentConfig{Type: unionMemberObject("User")},
+		},
+	}))
+	errs := graphql.ValidateDirectiveArguments(schema)
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	expected := []string{
+		`The type of @owner(user:) must be Input Type but got: User.`,
+	}
+	if !reflect.DeepEqual(expected, messages) {
+		t.Fatalf("unexpected errors, expected: %v, got: %v", expected, messages)
+	}
+}
+
+func TestValidateDirectiveArguments_AcceptsInputObjectArgument(t *testing.T) {
+	schema := schemaWithDirective(t, graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "owner",
+		Locations: []string{graphql.DirectiveLocationField},
+		Args: graphql.FieldConfigArgument{
+			"user": &graphql.ArgumentConfig{
+				Type: graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: "UserInput",
+					Fields: graphql.InputObjectConfigFieldMap{
+						"name": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					},
+				}),
+			},
+		},
+	}))
+	if errs := graphql.ValidateDirectiveArguments(schema); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
@@ -26,3 +26,36 @@ func TestSuggestionList_ReturnsOptionsSortedBasedOnSimilarity(t *testing.T) {
 		t.Fatalf("Expected %v, got: %v", expected, result)
 	}
 }
+func TestSuggestionList_RanksCloserOptionsFirst(t *testing.T) {
+	expected := []string{"name", "nme", "nameless"}
+	result := suggestionList("name", []string{"nameless", "nme", "name", "description"})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
+func TestLexicalDistance_CountsEdits(t *testing.T) {
+	if d := LexicalDistance("abc", "abc"); d != 0 {
+		t.Fatalf("Expected 0, got: %v", d)
+	}
+	if d := LexicalDistance("abc", "abd"); d != 1 {
+		t.Fatalf("Expected 1, got: %v", d)
+	}
+	if d := LexicalDistance("abc", "a"); d != 2 {
+		t.Fatalf("Expected 2, got: %v", d)
+	}
+}
+func TestLexicalDistance_CountsMultibyteCharactersOnce(t *testing.T) {
+	if d := LexicalDistance("naïve", "naive"); d != 1 {
+		t.Fatalf("Expected 1, got: %v", d)
+	}
+	if d := LexicalDistance("Größe", "Grösse"); d != 2 {
+		t.Fatalf("Expected 2, got: %v", d)
+	}
+}
+func TestSuggestionList_RanksMultibyteOptions(t *testing.T) {
+	expected := []string{"Bucher", "Bücherei"}
+	result := suggestionList("Bücher", []string{"Bücherei", "Zeitschrift", "Bucher"})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Expected %v, got: %v", expected, result)
+	}
+}
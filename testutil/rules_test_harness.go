@@ -361,6 +361,14 @@ func init() {
 					},
 				},
 			},
+			"intListArgField": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					"intListArg": &graphql.ArgumentConfig{
+						Type: graphql.NewList(graphql.Int),
+					},
+				},
+			},
 			"complexArgField": &graphql.Field{
 				Type: graphql.String,
 				Args: graphql.FieldConfigArgument{
@@ -456,6 +464,7 @@ func init() {
 		Directives: []*graphql.Directive{
 			graphql.IncludeDirective,
 			graphql.SkipDirective,
+			graphql.DeprecatedDirective,
 			graphql.NewDirective(graphql.DirectiveConfig{
 				Name:      "onQuery",
 				Locations: []string{graphql.DirectiveLocationQuery},
@@ -528,6 +537,31 @@ func init() {
 				Name:      "onInputFieldDefinition",
 				Locations: []string{graphql.DirectiveLocationInputFieldDefinition},
 			}),
+			graphql.NewDirective(graphql.DirectiveConfig{
+				Name:      "cacheControl",
+				Locations: []string{graphql.DirectiveLocationField},
+				Args: graphql.FieldConfigArgument{
+					"scope": &graphql.ArgumentConfig{
+						Type: graphql.NewEnum(graphql.EnumConfig{
+							Name: "CacheControlScope",
+							Values: graphql.EnumValueConfigMap{
+								"PUBLIC":  &graphql.EnumValueConfig{},
+								"PRIVATE": &graphql.EnumValueConfig{},
+							},
+						}),
+					},
+				},
+			}),
+			graphql.NewDirective(graphql.DirectiveConfig{
+				Name:      "tag",
+				Locations: []string{graphql.DirectiveLocationField},
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{
+						Type: graphql.String,
+					},
+				},
+				IsRepeatable: true,
+			}),
 		},
 		Types: []graphql.Type{
 			catType,
@@ -543,6 +577,9 @@ func init() {
 
 }
 func expectValidRule(t *testing.T, schema *graphql.Schema, rules []graphql.ValidationRuleFn, queryString string) {
+	expectValidRuleWithOptions(t, schema, rules, queryString, nil)
+}
+func expectValidRuleWithOptions(t *testing.T, schema *graphql.Schema, rules []graphql.ValidationRuleFn, queryString string, options *graphql.ValidationOptions) {
 	source := source.NewSource(&source.Source{
 		Body: []byte(queryString),
 	})
@@ -550,7 +587,7 @@ func expectValidRule(t *testing.T, schema *graphql.Schema, rules []graphql.Valid
 	if err != nil {
 		t.Fatal(err)
 	}
-	result := graphql.ValidateDocument(schema, AST, rules)
+	result := graphql.ValidateDocumentWithOptions(schema, AST, rules, options)
 	if len(result.Errors) > 0 {
 		t.Fatalf("Should validate, got %v", result.Errors)
 	}
@@ -560,6 +597,9 @@ func expectValidRule(t *testing.T, schema *graphql.Schema, rules []graphql.Valid
 
 }
 func expectInvalidRule(t *testing.T, schema *graphql.Schema, rules []graphql.ValidationRuleFn, queryString string, expectedErrors []gqlerrors.FormattedError) {
+	expectInvalidRuleWithOptions(t, schema, rules, queryString, expectedErrors, nil)
+}
+func expectInvalidRuleWithOptions(t *testing.T, schema *graphql.Schema, rules []graphql.ValidationRuleFn, queryString string, expectedErrors []gqlerrors.FormattedError, options *graphql.ValidationOptions) {
 	source := source.NewSource(&source.Source{
 		Body: []byte(queryString),
 	})
@@ -567,7 +607,7 @@ func expectInvalidRule(t *testing.T, schema *graphql.Schema, rules []graphql.Val
 	if err != nil {
 		t.Fatal(err)
 	}
-	result := graphql.ValidateDocument(schema, AST, rules)
+	result := graphql.ValidateDocumentWithOptions(schema, AST, rules, options)
 	if len(result.Errors) != len(expectedErrors) {
 		t.Fatalf("Should have %v errors, got %v", len(expectedErrors), len(result.Errors))
 	}
@@ -577,7 +617,7 @@ func expectInvalidRule(t *testing.T, schema *graphql.Schema, rules []graphql.Val
 	for _, expectedErr := range expectedErrors {
 		found := false
 		for _, err := range result.Errors {
-			if EqualFormattedError(expectedErr, err) {
+			if equalRuleError(expectedErr, err) {
 				found = true
 				break
 			}
@@ -600,6 +640,88 @@ func ExpectFailsRuleWithSchema(t *testing.T, schema *graphql.Schema, rule graphq
 func ExpectPassesRuleWithSchema(t *testing.T, schema *graphql.Schema, rule graphql.ValidationRuleFn, queryString string) {
 	expectValidRule(t, schema, []graphql.ValidationRuleFn{rule}, queryString)
 }
+func ExpectPassesRuleWithOptions(t *testing.T, rule graphql.ValidationRuleFn, queryString string, options *graphql.ValidationOptions) {
+	expectValidRuleWithOptions(t, TestSchema, []graphql.ValidationRuleFn{rule}, queryString, options)
+}
+func ExpectFailsRuleWithOptions(t *testing.T, rule graphql.ValidationRuleFn, queryString string, expectedErrors []gqlerrors.FormattedError, options *graphql.ValidationOptions) {
+	expectInvalidRuleWithOptions(t, TestSchema, []graphql.ValidationRuleFn{rule}, queryString, expectedErrors, options)
+}
+
+// ExpectWarnsRule checks that the rule reports no errors and exactly the
+// expected warnings; pass no warnings to assert the rule stays silent.
+func ExpectWarnsRule(t *testing.T, rule graphql.ValidationRuleFn, queryString string, expectedWarnings []gqlerrors.FormattedError) {
+	source := source.NewSource(&source.Source{
+		Body: []byte(queryString),
+	})
+	AST, err := parser.Parse(parser.ParseParams{Source: source})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := graphql.ValidateDocument(TestSchema, AST, []graphql.ValidationRuleFn{rule})
+	if len(result.Errors) > 0 {
+		t.Fatalf("Should validate, got %v", result.Errors)
+	}
+	if len(result.Warnings) != len(expectedWarnings) {
+		t.Fatalf("Should have %v warnings, got %v", len(expectedWarnings), len(result.Warnings))
+	}
+	for _, expected := range expectedWarnings {
+		found := false
+		for _, warning := range result.Warnings {
+			if equalRuleError(expected, warning.FormattedError) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("Unexpected result, Diff: %v", Diff(expectedWarnings, result.Warnings))
+		}
+	}
+}
+
+// ExpectPassesSDLRule checks that the rule reports no errors for the type
+// system document, extending schema when not nil.
+func ExpectPassesSDLRule(t *testing.T, schema *graphql.Schema, rule graphql.ValidationRuleFn, sdlString string) {
+	result := graphql.ValidateSDLWithRules(TestParse(t, sdlString), schema, []graphql.ValidationRuleFn{rule})
+	if len(result.Errors) > 0 {
+		t.Fatalf("Should validate, got %v", result.Errors)
+	}
+	if result.IsValid != true {
+		t.Fatalf("IsValid should be true, got %v", result.IsValid)
+	}
+}
+
+// ExpectFailsSDLRule checks that the rule reports exactly the expected errors
+// for the type system document, extending schema when not nil.
+func ExpectFailsSDLRule(t *testing.T, schema *graphql.Schema, rule graphql.ValidationRuleFn, sdlString string, expectedErrors []gqlerrors.FormattedError) {
+	result := graphql.ValidateSDLWithRules(TestParse(t, sdlString), schema, []graphql.ValidationRuleFn{rule})
+	if len(result.Errors) != len(expectedErrors) {
+		t.Fatalf("Should have %v errors, got %v", len(expectedErrors), len(result.Errors))
+	}
+	if result.IsValid != false {
+		t.Fatalf("IsValid should be false, got %v", result.IsValid)
+	}
+	for _, expectedErr := range expectedErrors {
+		found := false
+		for _, err := range result.Errors {
+			if equalRuleError(expectedErr, err) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("Unexpected result, Diff: %v", Diff(expectedErrors, result.Errors))
+		}
+	}
+}
+
+// equalRuleError compares the reported error with the expected one, ignoring
+// the extensions of the reported error unless the expected one sets some.
+func equalRuleError(expected, actual gqlerrors.FormattedError) bool {
+	if expected.Extensions == nil {
+		actual.Extensions = nil
+	}
+	return EqualFormattedError(expected, actual)
+}
 func RuleError(message string, locs ...int) gqlerrors.FormattedError {
 	locations := []location.SourceLocation{}
 	for i := 0; i < len(locs); i += 2 {
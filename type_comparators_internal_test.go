@@ -101,6 +101,15 @@ func TestIsTypeSubTypeOf_ListIsNotSubtypeOfItem(t *testing.T) {
 	}
 }
 
+func TestIsTypeSubTypeOf_NonNullListIsNotSubtypeOfItem(t *testing.T) {
+	schema := testSchemaForIsTypeSubTypeOfTest(t, Fields{
+		"field": &Field{Type: String},
+	})
+	if isTypeSubTypeOf(schema, NewNonNull(NewList(Int)), Int) {
+		t.Fatalf("Expected non-null list is not subtype of item")
+	}
+}
+
 func TestIsTypeSubTypeOf_MemberIsSubtypeOfUnion(t *testing.T) {
 	memberType := NewObject(ObjectConfig{
 		Name: "Object",
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+func TestTypeFromASTWithResolver_ResolvesVariableTypeLazily(t *testing.T) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: `query Q($filter: [Filter!]) { items(filter: $filter) }`,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	varDef := doc.Definitions[0].(*ast.OperationDefinition).VariableDefinitions[0]
+
+	built := map[string]Type{}
+	resolver := func(name string) Type {
+		if ttype, ok := built[name]; ok {
+			return ttype
+		}
+		if name != "Filter" {
+			return nil
+		}
+		built[name] = NewInputObject(InputObjectConfig{
+			Name: "Filter",
+			Fields: InputObjectConfigFieldMap{
+				"name": &InputObjectFieldConfig{Type: String},
+			},
+		})
+		return built[name]
+	}
+
+	ttype, err := typeFromASTWithResolver(resolver, varDef.Type)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := NewList(NewNonNull(built["Filter"]))
+	if !reflect.DeepEqual(expected, ttype) {
+		t.Fatalf("Expected %v, got: %v", expected, ttype)
+	}
+	if !IsInputType(ttype) {
+		t.Fatalf("Expected %v to be an input type", ttype)
+	}
+	if len(built) != 1 {
+		t.Fatalf("Expected only Filter to be built, got: %v", built)
+	}
+}
+func TestTypeFromASTWithResolver_UnknownTypeResolvesToNil(t *testing.T) {
+	named := ast.NewNamed(&ast.Named{Name: ast.NewName(&ast.Name{Value: "Unknown"})})
+	ttype, err := typeFromASTWithResolver(func(name string) Type { return nil }, named)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if ttype != nil {
+		t.Fatalf("Expected nil, got: %v", ttype)
+	}
+}
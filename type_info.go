@@ -22,6 +22,7 @@ type TypeInfo struct {
 	directive       *Directive
 	argument        *Argument
 	getFieldDef     fieldDefFn
+	resolveType     func(name string) Type
 }
 
 type TypeInfoConfig struct {
@@ -31,6 +32,10 @@ type TypeInfoConfig struct {
 	// to support non-spec-compliant codebases. You should never need to use it.
 	// It may disappear in the future.
 	FieldDefFn fieldDefFn
+
+	// TypeResolver, when set, resolves the named types which the schema's
+	// type map doesn't hold, e.g. those of a lazily built schema.
+	TypeResolver func(name string) Type
 }
 
 func NewTypeInfo(opts *TypeInfoConfig) *TypeInfo {
@@ -41,6 +46,7 @@ func NewTypeInfo(opts *TypeInfoConfig) *TypeInfo {
 	return &TypeInfo{
 		schema:      opts.Schema,
 		getFieldDef: getFieldDef,
+		resolveType: schemaTypeResolver(opts.Schema, opts.TypeResolver),
 	}
 }
 
@@ -121,7 +127,7 @@ func (ti *TypeInfo) Enter(node ast.Node) {
 	case *ast.InlineFragment:
 		typeConditionAST := node.TypeCondition
 		if typeConditionAST != nil {
-			ttype, _ = typeFromAST(*schema, node.TypeCondition)
+			ttype, _ = typeFromASTWithResolver(ti.resolveType, node.TypeCondition)
 			ti.typeStack = append(ti.typeStack, ttype)
 		} else {
 			ti.typeStack = append(ti.typeStack, ti.Type())
@@ -129,13 +135,13 @@ func (ti *TypeInfo) Enter(node ast.Node) {
 	case *ast.FragmentDefinition:
 		typeConditionAST := node.TypeCondition
 		if typeConditionAST != nil {
-			ttype, _ = typeFromAST(*schema, typeConditionAST)
+			ttype, _ = typeFromASTWithResolver(ti.resolveType, typeConditionAST)
 			ti.typeStack = append(ti.typeStack, ttype)
 		} else {
 			ti.typeStack = append(ti.typeStack, ti.Type())
 		}
 	case *ast.VariableDefinition:
-		ttype, _ = typeFromAST(*schema, node.Type)
+		ttype, _ = typeFromASTWithResolver(ti.resolveType, node.Type)
 		ti.inputTypeStack = append(ti.inputTypeStack, ttype)
 	case *ast.Argument:
 		nameVal := ""
@@ -0,0 +1,102 @@
+package graphql
+
+import (
+	"testing"
+)
+
+func TestTypesOverlap_CachedPossibleTypesMatchUncached(t *testing.T) {
+	resolveType := func(p ResolveTypeParams) *Object {
+		return nil
+	}
+	pet := NewInterface(InterfaceConfig{
+		Name:        "Pet",
+		ResolveType: resolveType,
+		Fields: Fields{
+			"name": &Field{Type: String},
+		},
+	})
+	being := NewInterface(InterfaceConfig{
+		Name:        "Being",
+		ResolveType: resolveType,
+		Fields: Fields{
+			"name": &Field{Type: String},
+		},
+	})
+	dog := NewObject(ObjectConfig{
+		Name:       "Dog",
+		Interfaces: []*Interface{pet, being},
+		Fields: Fields{
+			"name": &Field{Type: String},
+		},
+	})
+	cat := NewObject(ObjectConfig{
+		Name:       "Cat",
+		Interfaces: []*Interface{pet, being},
+		Fields: Fields{
+			"name": &Field{Type: String},
+		},
+	})
+	human := NewObject(ObjectConfig{
+		Name:       "Human",
+		Interfaces: []*Interface{being},
+		Fields: Fields{
+			"name": &Field{Type: String},
+		},
+	})
+	catOrDog := NewUnion(UnionConfig{
+		Name:        "CatOrDog",
+		ResolveType: resolveType,
+		Types:       []*Object{cat, dog},
+	})
+	humanOrAlien := NewUnion(UnionConfig{
+		Name:        "HumanOrAlien",
+		ResolveType: resolveType,
+		Types:       []*Object{human},
+	})
+	schema, err := NewSchema(SchemaConfig{
+		Query: NewObject(ObjectConfig{
+			Name: "Query",
+			Fields: Fields{
+				"pet":          &Field{Type: pet},
+				"being":        &Field{Type: being},
+				"catOrDog":     &Field{Type: catOrDog},
+				"humanOrAlien": &Field{Type: humanOrAlien},
+			},
+		}),
+		Types: []Type{dog, cat, human},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating schema: %v", err)
+	}
+
+	context := NewValidationContext(&schema, nil, nil)
+	types := []Type{pet, being, dog, cat, human, catOrDog, humanOrAlien}
+	for _, t1 := range types {
+		for _, t2 := range types {
+			uncached := doTypesOverlap(&schema, t1, t2)
+			// Ask twice, the second time is answered from the cache.
+			for i := 0; i < 2; i++ {
+				if cached := typesOverlap(context.possibleTypeNames, t1, t2); cached != uncached {
+					t.Fatalf("overlap of %v and %v: cached %v, uncached %v", t1, t2, cached, uncached)
+				}
+			}
+		}
+	}
+
+	expected := []struct {
+		t1, t2  Type
+		overlap bool
+	}{
+		{dog, pet, true},
+		{human, pet, false},
+		{pet, catOrDog, true},
+		{catOrDog, humanOrAlien, false},
+		{being, humanOrAlien, true},
+		{dog, cat, false},
+	}
+	for _, e := range expected {
+		if overlap := typesOverlap(context.possibleTypeNames, e.t1, e.t2); overlap != e.overlap {
+			t.Fatalf("overlap of %v and %v: expected %v, got %v", e.t1, e.t2, e.overlap, overlap)
+		}
+	}
+}
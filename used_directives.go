@@ -0,0 +1,84 @@
+package graphql
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/visitor"
+)
+
+// DirectiveUsage A directive applied in a document, e.g. @skip on a field.
+type DirectiveUsage struct {
+	// Name is the name of the directive, without the "@".
+	Name string
+	// Directive is the directive itself, locating the usage in the document.
+	Directive *ast.Directive
+	// Node is the node the directive is applied to, e.g. an *ast.Field or
+	// an *ast.FragmentSpread.
+	Node ast.Node
+}
+
+// UsedDirectives Returns the directives applied in the document, in document
+// order, whether the document is executable or defines types.
+func UsedDirectives(doc *ast.Document) []DirectiveUsage {
+	usages := []DirectiveUsage{}
+	if doc == nil {
+		return usages
+	}
+	visitor.Visit(doc, &visitor.VisitorOptions{
+		Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+			node, ok := p.Node.(ast.Node)
+			if !ok || node == nil {
+				return visitor.ActionNoChange, nil
+			}
+			for _, directive := range appliedDirectives(node) {
+				if directive == nil || directive.Name == nil {
+					continue
+				}
+				usages = append(usages, DirectiveUsage{
+					Name:      directive.Name.Value,
+					Directive: directive,
+					Node:      node,
+				})
+			}
+			return visitor.ActionNoChange, nil
+		},
+	}, nil)
+	return usages
+}
+
+// appliedDirectives Returns the directives applied to the node, if it is one
+// which takes directives.
+func appliedDirectives(node ast.Node) []*ast.Directive {
+	switch node := node.(type) {
+	case *ast.OperationDefinition:
+		return node.Directives
+	case *ast.FragmentDefinition:
+		return node.Directives
+	case *ast.Field:
+		return node.Directives
+	case *ast.FragmentSpread:
+		return node.Directives
+	case *ast.InlineFragment:
+		return node.Directives
+	case *ast.SchemaDefinition:
+		return node.Directives
+	case *ast.ScalarDefinition:
+		return node.Directives
+	case *ast.ObjectDefinition:
+		return node.Directives
+	case *ast.FieldDefinition:
+		return node.Directives
+	case *ast.InputValueDefinition:
+		return node.Directives
+	case *ast.InterfaceDefinition:
+		return node.Directives
+	case *ast.UnionDefinition:
+		return node.Directives
+	case *ast.EnumDefinition:
+		return node.Directives
+	case *ast.EnumValueDefinition:
+		return node.Directives
+	case *ast.InputObjectDefinition:
+		return node.Directives
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/location"
+	"github.com/graphql-go/graphql/testutil"
+)
+
+func TestUsedDirectives(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      query Q($skipName: Boolean!) {
+        dog {
+          name @skip(if: $skipName)
+          ...DogFields @defer
+        }
+      }
+      fragment DogFields on Dog {
+        barkVolume
+      }
+	`)
+	usages := graphql.UsedDirectives(doc)
+	if len(usages) != 2 {
+		t.Fatalf("Expected 2 directive usages, got: %v", len(usages))
+	}
+
+	names := []string{}
+	locations := []location.SourceLocation{}
+	for _, usage := range usages {
+		names = append(names, usage.Name)
+		loc := usage.Directive.GetLoc()
+		locations = append(locations, location.GetLocation(loc.Source, loc.Start))
+	}
+	expectedNames := []string{"skip", "defer"}
+	if !reflect.DeepEqual(expectedNames, names) {
+		t.Fatalf("Expected directives %v, got: %v", expectedNames, names)
+	}
+	expectedLocations := []location.SourceLocation{{Line: 4, Column: 16}, {Line: 5, Column: 24}}
+	if !reflect.DeepEqual(expectedLocations, locations) {
+		t.Fatalf("Expected locations %v, got: %v", expectedLocations, locations)
+	}
+
+	if field, ok := usages[0].Node.(*ast.Field); !ok || field.Name.Value != "name" {
+		t.Fatalf("Expected @skip to be applied to the name field, got: %v", usages[0].Node)
+	}
+	if spread, ok := usages[1].Node.(*ast.FragmentSpread); !ok || spread.Name.Value != "DogFields" {
+		t.Fatalf("Expected @defer to be applied to the DogFields spread, got: %v", usages[1].Node)
+	}
+}
+
+func TestUsedDirectives_NoDirectives(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { name } }`)
+	if usages := graphql.UsedDirectives(doc); len(usages) != 0 {
+		t.Fatalf("Expected no directive usages, got: %v", usages)
+	}
+}
@@ -1,15 +1,80 @@
 package graphql
 
 import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
 	"github.com/graphql-go/graphql/language/kinds"
+	"github.com/graphql-go/graphql/language/printer"
 	"github.com/graphql-go/graphql/language/visitor"
 )
 
+// ValidationResult The outcome of validating a document: the document is
+// valid when no rule reported an error, while warnings are only informative.
 type ValidationResult struct {
 	IsValid bool
 	Errors  []gqlerrors.FormattedError
+
+	// Warnings holds lint findings reported by optional rules. They don't
+	// affect IsValid.
+	Warnings []ValidationWarning
+
+	// NodesVisited counts the nodes of the document visited by the
+	// validation, once per node however many rules ran, to correlate the
+	// validation latency with the size of the document.
+	NodesVisited int
+
+	// FixedDocument holds the document as rewritten by the rules when it was
+	// validated with ValidationOptions.Fix.
+	FixedDocument *ast.Document
+}
+
+// ValidationWarning A lint finding, along with the rule which reported it.
+type ValidationWarning struct {
+	gqlerrors.FormattedError
+	rule string
+}
+
+// Rule Returns the name of the rule which reported the warning, e.g.
+// "NewRedundantInlineFragmentRule", as returned by RuleName.
+func (w ValidationWarning) Rule() string {
+	return w.rule
+}
+
+// HasWarnings Reports whether any rule reported a warning, which callers may
+// want to surface even when the document is valid.
+func (vr ValidationResult) HasWarnings() bool {
+	return len(vr.Warnings) > 0
+}
+
+// Merge Adds the errors and warnings of other to the result, e.g. to combine
+// the validation passes of a batch, which is then valid only if both were.
+func (vr *ValidationResult) Merge(other *ValidationResult) {
+	if other == nil {
+		return
+	}
+	vr.Errors = append(vr.Errors, other.Errors...)
+	vr.Warnings = append(vr.Warnings, other.Warnings...)
+	vr.NodesVisited += other.NodesVisited
+	vr.IsValid = len(vr.Errors) == 0
+}
+
+// WarningsByRule Returns the warnings reported by the rule with the given
+// name, e.g. to handle the warnings of some rules differently.
+func (vr ValidationResult) WarningsByRule(name string) []ValidationWarning {
+	warnings := []ValidationWarning{}
+	for _, warning := range vr.Warnings {
+		if warning.Rule() == name {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
 }
 
 /**
@@ -27,6 +92,184 @@ type ValidationResult struct {
  */
 
 func ValidateDocument(schema *Schema, astDoc *ast.Document, rules []ValidationRuleFn) (vr ValidationResult) {
+	return ValidateDocumentWithOptions(schema, astDoc, rules, nil)
+}
+
+// ValidationOptions holds optional settings that tune how a document is
+// validated. The zero value validates exactly like ValidateDocument.
+type ValidationOptions struct {
+	// SuggestionListFn replaces the "did you mean" ranking used by the rules
+	// when reporting unknown names.
+	SuggestionListFn SuggestionListFn
+
+	// AllowedMetaFields lists server-specific meta-fields, such as `_service`
+	// or `_entities`, which FieldsOnCorrectTypeRule accepts on the query root
+	// even though the query type doesn't define them.
+	AllowedMetaFields []string
+
+	// MessageFormatter builds the messages of the reported errors, e.g. to
+	// localize them. Defaults to DefaultMessageTemplates.
+	MessageFormatter MessageFormatter
+
+	// OnRuleComplete, when set, is called once per rule after the document
+	// was visited, with the name of the rule, e.g. "ScalarLeafsRule", and the
+	// time spent running it, to profile the validation of large documents.
+	OnRuleComplete func(ruleName string, d time.Duration)
+
+	// MaxErrors, when positive, caps the number of reported errors: the
+	// validation stops at the next error and reports a final error saying
+	// that only the first MaxErrors are shown.
+	MaxErrors int
+
+	// ExternallyUsedFragments lists fragments which are spread by other
+	// documents, e.g. when fragments are split across files, so that
+	// NoUnusedFragmentsRule considers them used.
+	ExternallyUsedFragments []string
+
+	// Fix runs the validation in fix mode, for autofix tools: a rule may then
+	// rewrite the document by returning visitor.ActionUpdate along with the
+	// replacement node, and the rewritten document is returned in
+	// ValidationResult.FixedDocument. The nodes of the validated document may
+	// be edited in place. Without Fix, such updates are ignored.
+	Fix bool
+
+	// MaxPrintedValueLength, when positive, caps the length of the invalid
+	// default values printed in the messages of DefaultValuesOfCorrectTypeRule:
+	// longer values, such as large list or object literals, are cut after
+	// that many characters and end with an ellipsis.
+	MaxPrintedValueLength int
+
+	// ValuePrinter, when set, prints the literal values embedded in the
+	// messages of ArgumentsOfCorrectTypeRule and DefaultValuesOfCorrectTypeRule
+	// instead of printer.Print, e.g. to redact or reformat them.
+	ValuePrinter func(value ast.Value) string
+
+	// TypeResolver, when set, resolves the named types which the schema's
+	// type map doesn't hold, e.g. those of a lazily built schema which are
+	// only materialized on demand. It should return the same Type for a name
+	// on every call, and nil for an unknown name.
+	TypeResolver func(name string) Type
+}
+
+// SuggestionListFn Given an invalid input string and a list of valid options,
+// returns the options worth suggesting, most similar first.
+type SuggestionListFn func(input string, options []string) []string
+
+// ValidateDocumentWithOptions is like ValidateDocument, but lets the caller
+// tune the validation through ValidationOptions.
+func ValidateDocumentWithOptions(schema *Schema, astDoc *ast.Document, rules []ValidationRuleFn, options *ValidationOptions) (vr ValidationResult) {
+	return validateDocument(schema, astDoc, rules, options, newDocumentCache(), newSchemaCache())
+}
+
+// ValidateAgainstSchemas validates a single parsed document against each of
+// the given schemas with the specified rules, e.g. when a gateway checks an
+// operation against several services. Lookups that only depend on the
+// document, such as fragments and their spreads, are computed once and shared
+// by every schema's validation.
+func ValidateAgainstSchemas(schemas []*Schema, astDoc *ast.Document) map[*Schema]*ValidationResult {
+	results := map[*Schema]*ValidationResult{}
+	cache := newDocumentCache()
+	for _, schema := range schemas {
+		vr := validateDocument(schema, astDoc, nil, nil, cache, newSchemaCache())
+		results[schema] = &vr
+	}
+	return results
+}
+
+// ValidateWithFragments validates the document with the SpecifiedRules like
+// ValidateDocument, resolving spreads of fragments the document doesn't define
+// against the given external fragments, e.g. when clients keep their
+// fragments in separate documents. The external fragments themselves are not
+// validated; fragments of the document take precedence over them.
+func ValidateWithFragments(schema *Schema, astDoc *ast.Document, externalFragments []*ast.FragmentDefinition) (vr ValidationResult) {
+	cache := newDocumentCache()
+	for _, fragment := range externalFragments {
+		if fragment != nil && fragment.Name != nil {
+			cache.fragments[fragment.Name.Value] = fragment
+		}
+	}
+	if astDoc != nil {
+		for _, def := range astDoc.Definitions {
+			if fragment, ok := def.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+				cache.fragments[fragment.Name.Value] = fragment
+			}
+		}
+	}
+	return validateDocument(schema, astDoc, nil, nil, cache, newSchemaCache())
+}
+
+// ValidateBatch validates each of the documents of a batch, as sent by clients
+// which batch their operations, against the schema with the SpecifiedRules.
+// The documents are validated independently, with one result per document in
+// the same order, but lookups that only depend on the schema, such as the
+// possible types of abstract types, are computed once for the whole batch.
+func ValidateBatch(schema *Schema, docs []*ast.Document) []*ValidationResult {
+	results := make([]*ValidationResult, 0, len(docs))
+	types := newSchemaCache()
+	for _, astDoc := range docs {
+		vr := validateDocument(schema, astDoc, nil, nil, newDocumentCache(), types)
+		results = append(results, &vr)
+	}
+	return results
+}
+
+// PersistedQueryStore Looks up the documents of persisted queries by their
+// id, as sent by clients instead of the query itself.
+type PersistedQueryStore interface {
+	Lookup(id string) (*ast.Document, bool)
+}
+
+// PersistedQueryMap A PersistedQueryStore holding its documents in memory.
+type PersistedQueryMap map[string]*ast.Document
+
+func (m PersistedQueryMap) Lookup(id string) (*ast.Document, bool) {
+	doc, ok := m[id]
+	return doc, ok
+}
+
+// ValidatePersisted validates the document stored under the id in the store
+// with the SpecifiedRules like ValidateDocument. If the store has no document
+// for the id, the result holds a single error with the extension code
+// "PERSISTED_QUERY_NOT_FOUND".
+func ValidatePersisted(schema *Schema, id string, store PersistedQueryStore) (vr ValidationResult) {
+	if store == nil {
+		vr.Errors = append(vr.Errors, gqlerrors.NewFormattedError("Must provide persisted query store"))
+		return vr
+	}
+	astDoc, ok := store.Lookup(id)
+	if !ok {
+		err := gqlerrors.NewFormattedError(DefaultMessageTemplates.Format("UnknownPersistedQuery", id))
+		err.Extensions = map[string]interface{}{
+			"code": "PERSISTED_QUERY_NOT_FOUND",
+		}
+		vr.Errors = append(vr.Errors, err)
+		return vr
+	}
+	return validateDocument(schema, astDoc, nil, nil, newDocumentCache(), newSchemaCache())
+}
+
+// ValidateStream validates the document with the specified rules like
+// ValidateDocument, but hands each error to onError as soon as it is reported
+// instead of collecting them, so that memory stays bounded for very large
+// documents. Returning false from onError stops the validation.
+func ValidateStream(schema *Schema, astDoc *ast.Document, onError func(err gqlerrors.FormattedError) bool) {
+	if schema == nil {
+		onError(gqlerrors.NewFormattedError("Must provide schema"))
+		return
+	}
+	if astDoc == nil {
+		onError(gqlerrors.NewFormattedError("Must provide document"))
+		return
+	}
+	typeInfo := NewTypeInfo(&TypeInfoConfig{
+		Schema: schema,
+	})
+	context := NewValidationContext(schema, astDoc, typeInfo)
+	context.onError = onError
+	visitUsingRules(context, typeInfo, astDoc, SpecifiedRules)
+}
+
+func validateDocument(schema *Schema, astDoc *ast.Document, rules []ValidationRuleFn, options *ValidationOptions, cache *documentCache, types *schemaCache) (vr ValidationResult) {
 	if len(rules) == 0 {
 		rules = SpecifiedRules
 	}
@@ -40,16 +283,102 @@ func ValidateDocument(schema *Schema, astDoc *ast.Document, rules []ValidationRu
 		return vr
 	}
 
+	var typeResolver func(name string) Type
+	if options != nil {
+		typeResolver = options.TypeResolver
+	}
 	typeInfo := NewTypeInfo(&TypeInfoConfig{
-		Schema: schema,
+		Schema:       schema,
+		TypeResolver: typeResolver,
 	})
-	vr.Errors = VisitUsingRules(schema, typeInfo, astDoc, rules)
+	context := NewValidationContext(schema, astDoc, typeInfo)
+	context.documentCache = cache
+	context.schemaCache = types
+	if options != nil {
+		context.options = *options
+	}
+	vr.Errors = visitUsingRules(context, typeInfo, astDoc, rules)
+	vr.Warnings = context.Warnings()
+	vr.NodesVisited = context.nodesVisited
+	if context.options.Fix {
+		vr.FixedDocument = astDoc
+		if context.fixedDocument != nil {
+			vr.FixedDocument = context.fixedDocument
+		}
+	}
 	if len(vr.Errors) == 0 {
 		vr.IsValid = true
 	}
 	return vr
 }
 
+// selectionSetRules The rules run by ValidateSelectionSet, which only need
+// the selection set and its parent type.
+var selectionSetRules = []ValidationRuleFn{
+	FieldsOnCorrectTypeRule,
+	ScalarLeafsRule,
+	OverlappingFieldsCanBeMergedRule,
+}
+
+// ValidateSelectionSet validates a selection set in isolation, given the type
+// it selects from, e.g. to validate the sub-selection of a field in an editor.
+// It runs the field, scalar leaf and overlap rules; fragments spread in the
+// selection set are unknown and ignored by them.
+func ValidateSelectionSet(schema *Schema, parentType Named, sel *ast.SelectionSet) *ValidationResult {
+	vr := &ValidationResult{}
+	if schema == nil {
+		vr.Errors = append(vr.Errors, gqlerrors.NewFormattedError("Must provide schema"))
+		return vr
+	}
+	if sel == nil {
+		vr.Errors = append(vr.Errors, gqlerrors.NewFormattedError("Must provide selection set"))
+		return vr
+	}
+
+	typeInfo := NewTypeInfo(&TypeInfoConfig{
+		Schema: schema,
+	})
+	// The selection set takes its parent type from the type of the enclosing
+	// field, so stand in for that field.
+	if ttype, ok := parentType.(Output); ok {
+		typeInfo.typeStack = append(typeInfo.typeStack, ttype)
+	}
+	context := NewValidationContext(schema, ast.NewDocument(nil), typeInfo)
+	vr.Errors = visitUsingRules(context, typeInfo, sel, selectionSetRules)
+	vr.Warnings = context.Warnings()
+	vr.NodesVisited = context.nodesVisited
+	vr.IsValid = len(vr.Errors) == 0
+	return vr
+}
+
+// ValidateSDL validates a type system (SDL) document, e.g. before building a
+// schema from it, with the SpecifiedSDLRules.
+func ValidateSDL(schemaDoc *ast.Document) (vr ValidationResult) {
+	return ValidateSDLWithRules(schemaDoc, nil, nil)
+}
+
+// ValidateSDLWithRules validates a type system (SDL) document with the
+// specified rules, or the SpecifiedSDLRules when none are given. When the
+// document extends an existing schema, pass it as schema so that the rules
+// also consider the types and directives it already defines; otherwise pass
+// nil. The SDL rules only look at the document, not at type info.
+func ValidateSDLWithRules(schemaDoc *ast.Document, schema *Schema, rules []ValidationRuleFn) (vr ValidationResult) {
+	if len(rules) == 0 {
+		rules = SpecifiedSDLRules
+	}
+	if schemaDoc == nil {
+		vr.Errors = append(vr.Errors, gqlerrors.NewFormattedError("Must provide document"))
+		return vr
+	}
+
+	context := NewValidationContext(schema, schemaDoc, nil)
+	vr.Errors = visitUsingRules(context, nil, schemaDoc, rules)
+	vr.Warnings = context.Warnings()
+	vr.NodesVisited = context.nodesVisited
+	vr.IsValid = len(vr.Errors) == 0
+	return vr
+}
+
 // VisitUsingRules This uses a specialized visitor which runs multiple visitors in parallel,
 // while maintaining the visitor skip and break API.
 //
@@ -57,20 +386,144 @@ func ValidateDocument(schema *Schema, astDoc *ast.Document, rules []ValidationRu
 // Had to expose it to unit test experimental customizable validation feature,
 // but not meant for public consumption
 func VisitUsingRules(schema *Schema, typeInfo *TypeInfo, astDoc *ast.Document, rules []ValidationRuleFn) []gqlerrors.FormattedError {
-
 	context := NewValidationContext(schema, astDoc, typeInfo)
+	return visitUsingRules(context, typeInfo, astDoc, rules)
+}
+
+// visitUsingRules visits root, usually the document of the context, with the
+// instances of all provided rules. The type info is only tracked when not nil,
+// as the SDL rules don't need it.
+func visitUsingRules(context *ValidationContext, typeInfo *TypeInfo, root ast.Node, rules []ValidationRuleFn) []gqlerrors.FormattedError {
 	visitors := []*visitor.VisitorOptions{}
+	onRuleComplete := context.options.OnRuleComplete
+	durations := make([]time.Duration, len(rules))
+	context.rules = rules
 
-	for _, rule := range rules {
+	for i, rule := range rules {
+		context.currentRule = i
+		var elapsed *time.Duration
+		if onRuleComplete != nil {
+			elapsed = &durations[i]
+		}
+		start := time.Now()
 		instance := rule(context)
-		visitors = append(visitors, instance.VisitorOpts)
+		if elapsed != nil {
+			*elapsed += time.Since(start)
+		}
+		visitors = append(visitors, ruleVisitor(context, i, instance.VisitorOpts, elapsed))
 	}
 
-	// Visit the whole document with each instance of all provided rules.
-	visitor.Visit(astDoc, visitor.VisitWithTypeInfo(typeInfo, visitor.VisitInParallel(visitors...)), nil)
+	visitorOpts := visitor.VisitInParallel(visitors...)
+	if typeInfo != nil {
+		visitorOpts = visitor.VisitWithTypeInfo(typeInfo, visitorOpts)
+	}
+	visitorOpts = countNodes(context, visitorOpts)
+	if context.onError != nil || context.options.MaxErrors > 0 {
+		visitorOpts = breakOnAbort(context, visitorOpts)
+	}
+	fixed := visitor.Visit(root, visitorOpts, nil)
+	if fixedDocument, ok := fixed.(*ast.Document); ok && context.options.Fix {
+		context.fixedDocument = fixedDocument
+	}
+	if onRuleComplete != nil {
+		for i, rule := range rules {
+			onRuleComplete(RuleName(rule), durations[i])
+		}
+	}
 	return context.Errors()
 }
 
+// ruleVisitor wraps the visitor of the rule at the given index so that the
+// context knows which rule reports, e.g. to tag its warnings, and adds the
+// time spent in its visit functions to elapsed when not nil. Updates of the
+// document are dropped unless validating in fix mode.
+func ruleVisitor(context *ValidationContext, index int, visitorOpts *visitor.VisitorOptions, elapsed *time.Duration) *visitor.VisitorOptions {
+	wrap := func(isLeaving bool) visitor.VisitFunc {
+		return func(p visitor.VisitFuncParams) (string, interface{}) {
+			node, ok := p.Node.(ast.Node)
+			if !ok {
+				return visitor.ActionNoChange, nil
+			}
+			fn := visitor.GetVisitFn(visitorOpts, node.GetKind(), isLeaving)
+			if fn == nil {
+				return visitor.ActionNoChange, nil
+			}
+			context.currentRule = index
+			var action string
+			var result interface{}
+			if elapsed == nil {
+				action, result = fn(p)
+			} else {
+				start := time.Now()
+				action, result = fn(p)
+				*elapsed += time.Since(start)
+			}
+			if action == visitor.ActionUpdate && !context.options.Fix {
+				return visitor.ActionNoChange, nil
+			}
+			return action, result
+		}
+	}
+	return &visitor.VisitorOptions{
+		Enter: wrap(false),
+		Leave: wrap(true),
+	}
+}
+
+// RuleName Returns the name of the function implementing the rule, e.g.
+// "ScalarLeafsRule", or for a configured rule the name of its constructor,
+// e.g. "NewMaxInputDepthRule".
+func RuleName(rule ValidationRuleFn) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(rule).Pointer())
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	// Drop the suffix of closures, e.g. "NewMaxInputDepthRule.func1".
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// countNodes counts each node entered by the visitor in the context.
+func countNodes(context *ValidationContext, visitorOpts *visitor.VisitorOptions) *visitor.VisitorOptions {
+	return &visitor.VisitorOptions{
+		Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+			context.nodesVisited++
+			return visitorOpts.Enter(p)
+		},
+		Leave: visitorOpts.Leave,
+	}
+}
+
+// breakOnAbort stops visiting the document once the error callback of the
+// context asked to abort the validation, or too many errors were reported.
+func breakOnAbort(context *ValidationContext, visitorOpts *visitor.VisitorOptions) *visitor.VisitorOptions {
+	return &visitor.VisitorOptions{
+		Enter: func(p visitor.VisitFuncParams) (string, interface{}) {
+			action, result := visitorOpts.Enter(p)
+			if context.isAborted() {
+				return visitor.ActionBreak, nil
+			}
+			return action, result
+		},
+		Leave: func(p visitor.VisitFuncParams) (string, interface{}) {
+			action, result := visitorOpts.Leave(p)
+			if context.isAborted() {
+				return visitor.ActionBreak, nil
+			}
+			return action, result
+		},
+	}
+}
+
 type HasSelectionSet interface {
 	GetKind() string
 	GetLoc() *ast.Location
@@ -86,38 +539,214 @@ type VariableUsage struct {
 }
 
 type ValidationContext struct {
-	schema                         *Schema
-	astDoc                         *ast.Document
-	typeInfo                       *TypeInfo
-	errors                         []gqlerrors.FormattedError
+	*documentCache
+	*schemaCache
+	schema   *Schema
+	astDoc   *ast.Document
+	typeInfo *TypeInfo
+	options  ValidationOptions
+	// mu guards the reported errors and warnings, and whether the validation
+	// was aborted, since rules may report from several goroutines.
+	mu                      sync.Mutex
+	errors                  []gqlerrors.FormattedError
+	warnings                []ValidationWarning
+	rules                   []ValidationRuleFn
+	currentRule             int
+	onError                 func(err gqlerrors.FormattedError) bool
+	aborted                 bool
+	nodesVisited            int
+	fixedDocument           *ast.Document
+	variableUsages          map[HasSelectionSet][]*VariableUsage
+	recursiveVariableUsages map[*ast.OperationDefinition][]*VariableUsage
+}
+
+// documentCache memoizes lookups which only depend on the document, not on
+// the schema, so they can be shared between validations of the same document.
+type documentCache struct {
 	fragments                      map[string]*ast.FragmentDefinition
-	variableUsages                 map[HasSelectionSet][]*VariableUsage
-	recursiveVariableUsages        map[*ast.OperationDefinition][]*VariableUsage
 	recursivelyReferencedFragments map[*ast.OperationDefinition][]*ast.FragmentDefinition
 	fragmentSpreads                map[*ast.SelectionSet][]*ast.FragmentSpread
 }
 
-func NewValidationContext(schema *Schema, astDoc *ast.Document, typeInfo *TypeInfo) *ValidationContext {
-	return &ValidationContext{
-		schema:                         schema,
-		astDoc:                         astDoc,
-		typeInfo:                       typeInfo,
+func newDocumentCache() *documentCache {
+	return &documentCache{
 		fragments:                      map[string]*ast.FragmentDefinition{},
-		variableUsages:                 map[HasSelectionSet][]*VariableUsage{},
-		recursiveVariableUsages:        map[*ast.OperationDefinition][]*VariableUsage{},
 		recursivelyReferencedFragments: map[*ast.OperationDefinition][]*ast.FragmentDefinition{},
 		fragmentSpreads:                map[*ast.SelectionSet][]*ast.FragmentSpread{},
 	}
 }
 
+// schemaCache memoizes lookups which only depend on the schema, not on the
+// document, so they can be shared between validations against the same schema.
+type schemaCache struct {
+	possibleTypeNamesCache map[Abstract]map[string]bool
+}
+
+func newSchemaCache() *schemaCache {
+	return &schemaCache{
+		possibleTypeNamesCache: map[Abstract]map[string]bool{},
+	}
+}
+
+func NewValidationContext(schema *Schema, astDoc *ast.Document, typeInfo *TypeInfo) *ValidationContext {
+	return &ValidationContext{
+		documentCache:           newDocumentCache(),
+		schema:                  schema,
+		astDoc:                  astDoc,
+		typeInfo:                typeInfo,
+		variableUsages:          map[HasSelectionSet][]*VariableUsage{},
+		recursiveVariableUsages: map[*ast.OperationDefinition][]*VariableUsage{},
+		schemaCache:             newSchemaCache(),
+	}
+}
+
+// ReportError records an error which makes the document invalid. It is safe
+// to call from several goroutines, e.g. when a custom rule checks nodes in
+// parallel, as long as they are done before the visit function which started
+// them returns.
 func (ctx *ValidationContext) ReportError(err error) {
 	formattedErr := gqlerrors.FormatError(err)
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	if ctx.aborted {
+		return
+	}
+	if ctx.onError != nil {
+		ctx.aborted = !ctx.onError(formattedErr)
+		return
+	}
+	if maxErrors := ctx.options.MaxErrors; maxErrors > 0 && len(ctx.errors) >= maxErrors {
+		ctx.errors = append(ctx.errors, gqlerrors.NewFormattedError(
+			fmt.Sprintf("Too many validation errors, only showing first %v.", maxErrors),
+		))
+		ctx.aborted = true
+		return
+	}
 	ctx.errors = append(ctx.errors, formattedErr)
 }
+
+func (ctx *ValidationContext) isAborted() bool {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	return ctx.aborted
+}
+
+// ReportValidationError reports an error with the given message located at
+// the given nodes, the way the specified rules do. It lets custom rules
+// report errors without building them.
+func (ctx *ValidationContext) ReportValidationError(message string, nodes []ast.Node) {
+	ctx.ReportError(newValidationError(message, nodes))
+}
 func (ctx *ValidationContext) Errors() []gqlerrors.FormattedError {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
 	return ctx.errors
 }
 
+// ReportWarning records a lint finding which, unlike a reported error,
+// doesn't make the document invalid. Like ReportError, it is safe to call
+// from several goroutines.
+func (ctx *ValidationContext) ReportWarning(err error) {
+	warning := ValidationWarning{
+		FormattedError: gqlerrors.FormatError(err),
+	}
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	if ctx.currentRule < len(ctx.rules) {
+		warning.rule = RuleName(ctx.rules[ctx.currentRule])
+	}
+	ctx.warnings = append(ctx.warnings, warning)
+}
+func (ctx *ValidationContext) Warnings() []ValidationWarning {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	return ctx.warnings
+}
+
+// SuggestionList Given an invalid input string and a list of valid options,
+// returns a filtered list of valid options sorted based on their similarity
+// with the input. Rules use it for their "did you mean" hints; the ranking
+// can be replaced through ValidationOptions.SuggestionListFn.
+func (ctx *ValidationContext) SuggestionList(input string, options []string) []string {
+	if ctx.options.SuggestionListFn != nil {
+		return ctx.options.SuggestionListFn(input, options)
+	}
+	return suggestionList(input, options)
+}
+
+// FormatMessage Formats the validation message with the given key through the
+// configured MessageFormatter.
+func (ctx *ValidationContext) FormatMessage(key string, args ...interface{}) string {
+	return ctx.messageFormatter().Format(key, args...)
+}
+
+func (ctx *ValidationContext) messageFormatter() MessageFormatter {
+	if ctx.options.MessageFormatter != nil {
+		return ctx.options.MessageFormatter
+	}
+	return DefaultMessageTemplates
+}
+
+// valuePrinter Returns the function printing values in messages: the
+// ValuePrinter option, or else printer.Print.
+func (ctx *ValidationContext) valuePrinter() func(value ast.Value) string {
+	if ctx.options.ValuePrinter != nil {
+		return ctx.options.ValuePrinter
+	}
+	return printLiteral
+}
+
+// printLiteral Prints the value with printer.Print, the default ValuePrinter.
+func printLiteral(value ast.Value) string {
+	return fmt.Sprintf("%v", printer.Print(value))
+}
+
+// printValue Prints the value for a message, truncated to the
+// MaxPrintedValueLength option.
+func (ctx *ValidationContext) printValue(value ast.Value) string {
+	printed := ctx.valuePrinter()(value)
+	max := ctx.options.MaxPrintedValueLength
+	if runes := []rune(printed); max > 0 && len(runes) > max {
+		return string(runes[:max]) + "..."
+	}
+	return printed
+}
+
+// possibleTypeNames Returns the names of the possible types of the abstract
+// type, which are looked up once per validation.
+func (ctx *ValidationContext) possibleTypeNames(ttype Abstract) map[string]bool {
+	if typeNames, ok := ctx.possibleTypeNamesCache[ttype]; ok {
+		return typeNames
+	}
+	typeNames := possibleTypeNames(ctx.schema, ttype)
+	ctx.possibleTypeNamesCache[ttype] = typeNames
+	return typeNames
+}
+
+// resolveType Returns the named type of the given name, looked up in the
+// schema, or else with the TypeResolver option.
+func (ctx *ValidationContext) resolveType(name string) Type {
+	return schemaTypeResolver(ctx.schema, ctx.options.TypeResolver)(name)
+}
+
+// typeFromAST Returns the type the type AST refers to, resolving the named
+// type with resolveType.
+func (ctx *ValidationContext) typeFromAST(typeAST ast.Type) (Type, error) {
+	return typeFromASTWithResolver(ctx.resolveType, typeAST)
+}
+
+func (ctx *ValidationContext) isAllowedMetaField(parentType Composite, fieldName string) bool {
+	if parentType == nil || ctx.schema == nil || parentType != Composite(ctx.schema.QueryType()) {
+		return false
+	}
+	for _, name := range ctx.options.AllowedMetaFields {
+		if name == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
 func (ctx *ValidationContext) Schema() *Schema {
 	return ctx.schema
 }
@@ -145,6 +774,23 @@ func (ctx *ValidationContext) Fragment(name string) *ast.FragmentDefinition {
 	f, _ := ctx.fragments[name]
 	return f
 }
+
+// hasFragments Reports whether the document defines any fragment, or
+// external fragments were given to resolve its spreads.
+func (ctx *ValidationContext) hasFragments() bool {
+	if len(ctx.fragments) > 0 {
+		return true
+	}
+	if ctx.Document() == nil {
+		return false
+	}
+	for _, def := range ctx.Document().Definitions {
+		if _, ok := def.(*ast.FragmentDefinition); ok {
+			return true
+		}
+	}
+	return false
+}
 func (ctx *ValidationContext) FragmentSpreads(node *ast.SelectionSet) []*ast.FragmentSpread {
 	if spreads, ok := ctx.fragmentSpreads[node]; ok && spreads != nil {
 		return spreads
@@ -187,6 +833,11 @@ func (ctx *ValidationContext) RecursivelyReferencedFragments(operation *ast.Oper
 	}
 
 	fragments := []*ast.FragmentDefinition{}
+	// Without any fragment to spread there is nothing to collect, so skip
+	// walking the operation's selections.
+	if !ctx.hasFragments() {
+		return fragments
+	}
 	collectedNames := map[string]bool{}
 	nodesToVisit := []*ast.SelectionSet{operation.SelectionSet}
 
@@ -219,6 +870,16 @@ func (ctx *ValidationContext) RecursivelyReferencedFragments(operation *ast.Oper
 	ctx.recursivelyReferencedFragments[operation] = fragments
 	return fragments
 }
+
+// ReachableFragments Returns the fragments an operation spreads, directly or
+// through other fragments, i.e. the fragments needed to execute it. Each
+// fragment is listed once, even when fragments spread each other in a cycle.
+func (ctx *ValidationContext) ReachableFragments(op *ast.OperationDefinition) []*ast.FragmentDefinition {
+	if op == nil {
+		return []*ast.FragmentDefinition{}
+	}
+	return ctx.RecursivelyReferencedFragments(op)
+}
 func (ctx *ValidationContext) VariableUsages(node HasSelectionSet) []*VariableUsage {
 	if usages, ok := ctx.variableUsages[node]; ok && usages != nil {
 		return usages
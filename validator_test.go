@@ -1,14 +1,21 @@
 package graphql_test
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/gqlerrors"
 	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/kinds"
 	"github.com/graphql-go/graphql/language/location"
 	"github.com/graphql-go/graphql/language/parser"
 	"github.com/graphql-go/graphql/language/source"
+	"github.com/graphql-go/graphql/language/visitor"
 	"github.com/graphql-go/graphql/testutil"
 )
 
@@ -95,3 +102,694 @@ func TestValidator_SupportsFullValidation_ValidatesUsingACustomTypeInfo(t *testi
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedErrors, errors))
 	}
 }
+
+func TestValidator_SupportsFullValidation_UsesACustomSuggestionList(t *testing.T) {
+	ast := testutil.TestParse(t, `
+      {
+        dog {
+          nme
+        }
+      }
+	`)
+	result := graphql.ValidateDocumentWithOptions(testutil.TestSchema, ast, []graphql.ValidationRuleFn{graphql.FieldsOnCorrectTypeRule}, &graphql.ValidationOptions{
+		SuggestionListFn: func(input string, options []string) []string {
+			return []string{"alwaysThis"}
+		},
+	})
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message: `Cannot query field "nme" on type "Dog". Did you mean "alwaysThis"?`,
+			Locations: []location.SourceLocation{
+				{Line: 4, Column: 11},
+			},
+		},
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
+	}
+}
+
+func TestValidator_ValidateAgainstSchemas_ReturnsAResultPerSchema(t *testing.T) {
+	schemaA, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"shared":  &graphql.Field{Type: graphql.String},
+				"onlyInA": &graphql.Field{Type: graphql.String},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	schemaB, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"shared":  &graphql.Field{Type: graphql.String},
+				"onlyInB": &graphql.Field{Type: graphql.String},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error in schema %v", err.Error())
+	}
+	ast := testutil.TestParse(t, `
+      query Q {
+        ...F
+      }
+      fragment F on Query {
+        shared
+        onlyInA
+      }
+	`)
+
+	results := graphql.ValidateAgainstSchemas([]*graphql.Schema{&schemaA, &schemaB}, ast)
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %v", len(results))
+	}
+	if resultA := results[&schemaA]; !resultA.IsValid {
+		t.Fatalf("Expected document to be valid against schema A, got %v", resultA.Errors)
+	}
+	expectedErrors := []gqlerrors.FormattedError{
+		{
+			Message: `Cannot query field "onlyInA" on type "Query". Did you mean "onlyInB"?`,
+			Locations: []location.SourceLocation{
+				{Line: 7, Column: 9},
+			},
+		},
+	}
+	resultB := results[&schemaB]
+	if resultB.IsValid {
+		t.Fatalf("Expected document to be invalid against schema B")
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, resultB.Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedErrors, resultB.Errors))
+	}
+}
+
+func TestValidator_ValidationContext_ReachableFragments(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      query Q {
+        dog {
+          ...A
+        }
+      }
+      fragment A on Dog {
+        name
+        ...B
+      }
+      fragment B on Dog {
+        nickname
+        ...C
+      }
+      fragment C on Dog {
+        barkVolume
+        ...A
+      }
+      fragment Unrelated on Dog {
+        name
+      }
+	`)
+	typeInfo := graphql.NewTypeInfo(&graphql.TypeInfoConfig{
+		Schema: testutil.TestSchema,
+	})
+	context := graphql.NewValidationContext(testutil.TestSchema, doc, typeInfo)
+	operation, ok := doc.Definitions[0].(*ast.OperationDefinition)
+	if !ok {
+		t.Fatalf("Expected an operation definition, got %v", doc.Definitions[0])
+	}
+
+	names := []string{}
+	for _, fragment := range context.ReachableFragments(operation) {
+		names = append(names, fragment.Name.Value)
+	}
+	sort.Strings(names)
+	expected := []string{"A", "B", "C"}
+	if !reflect.DeepEqual(expected, names) {
+		t.Fatalf("Unexpected reachable fragments, expected: %v, got: %v", expected, names)
+	}
+}
+
+func TestValidator_ValidationResult_ValidDocument(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { name } }`)
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.FieldsOnCorrectTypeRule,
+		graphql.NewRedundantInlineFragmentRule(),
+	})
+	if !result.IsValid || len(result.Errors) != 0 || result.HasWarnings() {
+		t.Fatalf("Expected a valid result without warnings, got %+v", result)
+	}
+}
+
+func TestValidator_ValidationResult_DocumentWithErrors(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { unknownField } }`)
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.FieldsOnCorrectTypeRule,
+		graphql.NewRedundantInlineFragmentRule(),
+	})
+	if result.IsValid || len(result.Errors) != 1 || result.HasWarnings() {
+		t.Fatalf("Expected an invalid result with one error, got %+v", result)
+	}
+}
+
+func TestValidator_ValidationResult_DocumentWithWarningsOnly(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { ... on Dog { name } } }`)
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.FieldsOnCorrectTypeRule,
+		graphql.NewRedundantInlineFragmentRule(),
+	})
+	if !result.IsValid || len(result.Errors) != 0 {
+		t.Fatalf("Expected a valid result, got %+v", result)
+	}
+	if !result.HasWarnings() || len(result.Warnings) != 1 {
+		t.Fatalf("Expected one warning, got %v", result.Warnings)
+	}
+}
+
+// deprecatedFieldsRule warns about each field of the document which is
+// deprecated in the schema.
+func deprecatedFieldsRule(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+	return &graphql.ValidationRuleInstance{
+		VisitorOpts: &visitor.VisitorOptions{
+			KindFuncMap: map[string]visitor.NamedVisitFuncs{
+				kinds.Field: {
+					Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+						fieldDef := context.FieldDef()
+						if field, ok := p.Node.(*ast.Field); ok && fieldDef != nil && fieldDef.DeprecationReason != "" {
+							context.ReportWarning(gqlerrors.NewError(
+								fmt.Sprintf(`Field "%v" is deprecated.`, fieldDef.Name),
+								[]ast.Node{field}, "", nil, []int{}, nil,
+							))
+						}
+						return visitor.ActionNoChange, nil
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidator_ValidationResult_WarningsByRule(t *testing.T) {
+	petType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Pet",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+			"nickname": &graphql.Field{
+				Type:              graphql.String,
+				DeprecationReason: "Use name instead.",
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pet": &graphql.Field{Type: petType},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	doc := testutil.TestParse(t, `{ pet { nickname ... on Pet { name } } }`)
+	result := graphql.ValidateDocument(&schema, doc, []graphql.ValidationRuleFn{
+		deprecatedFieldsRule,
+		graphql.NewRedundantInlineFragmentRule(),
+	})
+	if len(result.Warnings) != 2 {
+		t.Fatalf("Expected two warnings, got %v", result.Warnings)
+	}
+
+	deprecations := result.WarningsByRule("deprecatedFieldsRule")
+	if len(deprecations) != 1 || deprecations[0].Message != `Field "nickname" is deprecated.` {
+		t.Fatalf("Unexpected deprecation warnings, got %v", deprecations)
+	}
+
+	others := []graphql.ValidationWarning{}
+	for _, warning := range result.Warnings {
+		if warning.Rule() != "deprecatedFieldsRule" {
+			others = append(others, warning)
+		}
+	}
+	if len(others) != 1 || others[0].Rule() != "NewRedundantInlineFragmentRule" {
+		t.Fatalf("Expected the redundant inline fragment warning only, got %v", others)
+	}
+}
+
+func TestValidator_ValidateStream_ReportsEachError(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          unknownA
+          unknownB
+          unknownC
+        }
+      }
+	`)
+	messages := []string{}
+	graphql.ValidateStream(testutil.TestSchema, doc, func(err gqlerrors.FormattedError) bool {
+		messages = append(messages, err.Message)
+		return true
+	})
+	if len(messages) != 3 {
+		t.Fatalf("Expected 3 errors, got %v", messages)
+	}
+}
+
+func TestValidator_ValidateStream_AbortsWhenCallbackReturnsFalse(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          unknownA
+          unknownB
+          unknownC
+        }
+      }
+	`)
+	calls := 0
+	graphql.ValidateStream(testutil.TestSchema, doc, func(err gqlerrors.FormattedError) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Fatalf("Expected validation to stop after the first error, got %v calls", calls)
+	}
+}
+
+func TestValidator_ValidateStream_ValidDocument(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { name } }`)
+	graphql.ValidateStream(testutil.TestSchema, doc, func(err gqlerrors.FormattedError) bool {
+		t.Fatalf("Unexpected error: %v", err)
+		return true
+	})
+}
+
+func TestValidator_OnRuleComplete_CalledOncePerRule(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          name
+          ...DogFields
+        }
+      }
+      fragment DogFields on Dog {
+        barkVolume
+      }
+	`)
+	names := []string{}
+	graphql.ValidateDocumentWithOptions(testutil.TestSchema, doc, []graphql.ValidationRuleFn{
+		graphql.ScalarLeafsRule,
+		graphql.OverlappingFieldsCanBeMergedRule,
+		graphql.NewMaxInputDepthRule(3),
+	}, &graphql.ValidationOptions{
+		OnRuleComplete: func(ruleName string, d time.Duration) {
+			if d < 0 {
+				t.Fatalf("Unexpected negative duration for %v: %v", ruleName, d)
+			}
+			names = append(names, ruleName)
+		},
+	})
+	expected := []string{"ScalarLeafsRule", "OverlappingFieldsCanBeMergedRule", "NewMaxInputDepthRule"}
+	if !reflect.DeepEqual(expected, names) {
+		t.Fatalf("Unexpected rule names, expected: %v, got: %v", expected, names)
+	}
+}
+
+func TestValidator_ValidationResult_Merge(t *testing.T) {
+	rules := []graphql.ValidationRuleFn{graphql.FieldsOnCorrectTypeRule}
+	valid := graphql.ValidateDocument(testutil.TestSchema, testutil.TestParse(t, `{ dog { name } }`), rules)
+	invalid := graphql.ValidateDocument(testutil.TestSchema, testutil.TestParse(t, `
+      {
+        dog {
+          unknownA
+          unknownB
+        }
+      }
+	`), rules)
+
+	valid.Merge(&invalid)
+	if valid.IsValid {
+		t.Fatalf("Expected the merged result to be invalid")
+	}
+	expectedErrors := []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot query field "unknownA" on type "Dog".`, 4, 11),
+		testutil.RuleError(`Cannot query field "unknownB" on type "Dog".`, 5, 11),
+	}
+	if !testutil.EqualFormattedErrors(expectedErrors, valid.Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedErrors, valid.Errors))
+	}
+}
+
+func TestValidator_ValidateSelectionSet(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { name unknownField ...Unknown } }`)
+	op := doc.Definitions[0].(*ast.OperationDefinition)
+	dogField := op.SelectionSet.Selections[0].(*ast.Field)
+
+	result := graphql.ValidateSelectionSet(testutil.TestSchema, testutil.TestSchema.Type("Dog"), dogField.SelectionSet)
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected one error, got %v", result.Errors)
+	}
+	expected := testutil.RuleError(`Cannot query field "unknownField" on type "Dog".`, 1, 14)
+	if !testutil.EqualFormattedError(expected, result.Errors[0]) {
+		t.Fatalf("Unexpected error, expected: %v, got: %v", expected, result.Errors[0])
+	}
+
+	op = testutil.TestParse(t, `{ name barkVolume }`).Definitions[0].(*ast.OperationDefinition)
+	valid := graphql.ValidateSelectionSet(testutil.TestSchema, testutil.TestSchema.Type("Dog"), op.SelectionSet)
+	if !valid.IsValid {
+		t.Fatalf("Expected a valid result, got %v", valid.Errors)
+	}
+}
+
+func TestValidator_MaxErrors(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          unknownA
+          unknownB
+          unknownC
+          unknownD
+          unknownE
+        }
+      }
+	`)
+	result := graphql.ValidateDocumentWithOptions(testutil.TestSchema, doc, nil, &graphql.ValidationOptions{
+		MaxErrors: 2,
+	})
+	messages := []string{}
+	for _, err := range result.Errors {
+		messages = append(messages, err.Message)
+	}
+	expected := []string{
+		`Cannot query field "unknownA" on type "Dog".`,
+		`Cannot query field "unknownB" on type "Dog".`,
+		`Too many validation errors, only showing first 2.`,
+	}
+	if result.IsValid || !reflect.DeepEqual(expected, messages) {
+		t.Fatalf("Unexpected errors, expected: %v, got: %v", expected, messages)
+	}
+}
+
+func externalFragments(t *testing.T, queryString string) []*ast.FragmentDefinition {
+	fragments := []*ast.FragmentDefinition{}
+	for _, def := range testutil.TestParse(t, queryString).Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			fragments = append(fragments, fragment)
+		}
+	}
+	return fragments
+}
+
+func TestValidator_ValidateWithFragments_ResolvesExternalFragment(t *testing.T) {
+	fragments := externalFragments(t, `
+      fragment dogFields on Dog {
+        name
+        barkVolume
+      }
+    `)
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          ...dogFields
+        }
+      }
+    `)
+	if result := graphql.ValidateDocument(testutil.TestSchema, doc, nil); result.IsValid {
+		t.Fatalf("Expected the unknown fragment to be reported without the external fragments")
+	}
+	result := graphql.ValidateWithFragments(testutil.TestSchema, doc, fragments)
+	if !result.IsValid {
+		t.Fatalf("Expected a valid result, got %v", result.Errors)
+	}
+}
+
+func TestValidator_ValidateWithFragments_ChecksSpreadOfExternalFragment(t *testing.T) {
+	fragments := externalFragments(t, `
+      fragment catFields on Cat {
+        meows
+      }
+    `)
+	doc := testutil.TestParse(t, `
+      {
+        dog {
+          ...catFields
+        }
+      }
+    `)
+	result := graphql.ValidateWithFragments(testutil.TestSchema, doc, fragments)
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment "catFields" cannot be spread here as objects of type "Dog" can never be of type "Cat".`, 4, 11),
+	}
+	if len(result.Errors) != 1 || !testutil.EqualFormattedError(expected[0], result.Errors[0]) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
+
+func TestValidator_ValidateBatch_ValidatesDocumentsIndependently(t *testing.T) {
+	valid := testutil.TestParse(t, `
+      {
+        pet {
+          ... on Dog {
+            name
+          }
+        }
+      }
+    `)
+	invalid := testutil.TestParse(t, `
+      {
+        pet {
+          ... on Dog {
+            meows
+          }
+        }
+      }
+    `)
+
+	results := graphql.ValidateBatch(testutil.TestSchema, []*ast.Document{valid, invalid})
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %v", len(results))
+	}
+	if !results[0].IsValid {
+		t.Fatalf("Expected the first document to be valid, got %v", results[0].Errors)
+	}
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot query field "meows" on type "Dog".`, 5, 13),
+	}
+	if results[1].IsValid {
+		t.Fatalf("Expected the second document to be invalid")
+	}
+	if !testutil.EqualFormattedErrors(expected, results[1].Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, results[1].Errors))
+	}
+}
+
+func TestValidator_ValidatePersisted_ValidatesStoredDocument(t *testing.T) {
+	store := graphql.PersistedQueryMap{
+		"dogName":  testutil.TestParse(t, `{ dog { name } }`),
+		"dogMeows": testutil.TestParse(t, `{ dog { meows } }`),
+	}
+	if result := graphql.ValidatePersisted(testutil.TestSchema, "dogName", store); !result.IsValid {
+		t.Fatalf("Expected a valid result, got %v", result.Errors)
+	}
+	result := graphql.ValidatePersisted(testutil.TestSchema, "dogMeows", store)
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`Cannot query field "meows" on type "Dog".`, 1, 9),
+	}
+	if result.IsValid || !testutil.EqualFormattedErrors(expected, result.Errors) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
+
+func TestValidator_ValidatePersisted_UnknownID(t *testing.T) {
+	store := graphql.PersistedQueryMap{
+		"dogName": testutil.TestParse(t, `{ dog { name } }`),
+	}
+	result := graphql.ValidatePersisted(testutil.TestSchema, "catName", store)
+	if result.IsValid || len(result.Errors) != 1 {
+		t.Fatalf("Expected a single error, got %v", result.Errors)
+	}
+	if err := result.Errors[0]; err.Message != `Unknown persisted query "catName".` || err.Extensions["code"] != "PERSISTED_QUERY_NOT_FOUND" {
+		t.Fatalf("Unexpected error: %+v", err)
+	}
+}
+
+func TestValidator_ValidatePersisted_NilStore(t *testing.T) {
+	result := graphql.ValidatePersisted(testutil.TestSchema, "dogName", nil)
+	if result.IsValid || len(result.Errors) != 1 || result.Errors[0].Message != "Must provide persisted query store" {
+		t.Fatalf("Expected a single error, got %v", result.Errors)
+	}
+}
+
+func TestValidator_NodesVisited(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { name } }`)
+	// Document, operation, selection set, dog and its name, selection set,
+	// name and its name.
+	expected := 8
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, nil)
+	if result.NodesVisited != expected {
+		t.Fatalf("Expected %v nodes visited, got %v", expected, result.NodesVisited)
+	}
+	result = graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{graphql.ScalarLeafsRule})
+	if result.NodesVisited != expected {
+		t.Fatalf("Expected %v nodes visited with a single rule, got %v", expected, result.NodesVisited)
+	}
+}
+
+func TestValidator_VariableRulesWithAndWithoutFragments(t *testing.T) {
+	rules := []graphql.ValidationRuleFn{
+		graphql.NoUndefinedVariablesRule,
+		graphql.NoUnusedVariablesRule,
+	}
+	withoutFragments := testutil.TestParse(t, `
+      query Q($used: Boolean, $unused: Boolean) {
+        dog {
+          name @include(if: $used)
+          isHousetrained(atOtherHomes: $undefined)
+        }
+      }
+	`)
+	expected := []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$undefined" is not defined by operation "Q".`, 5, 40, 2, 7),
+		testutil.RuleError(`Variable "$unused" is never used in operation "Q".`, 2, 31),
+	}
+	result := graphql.ValidateDocument(testutil.TestSchema, withoutFragments, rules)
+	if !testutil.EqualFormattedErrors(expected, result.Errors) {
+		t.Fatalf("Unexpected errors without fragments, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+
+	withFragments := testutil.TestParse(t, `
+      query Q($used: Boolean, $unused: Boolean) {
+        dog {
+          ...A
+        }
+      }
+      fragment A on Dog {
+        name @include(if: $used)
+        ...B
+      }
+      fragment B on Dog {
+        isHousetrained(atOtherHomes: $undefined)
+        ...A
+      }
+	`)
+	expected = []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$undefined" is not defined by operation "Q".`, 12, 38, 2, 7),
+		testutil.RuleError(`Variable "$unused" is never used in operation "Q".`, 2, 31),
+	}
+	result = graphql.ValidateDocument(testutil.TestSchema, withFragments, rules)
+	if !testutil.EqualFormattedErrors(expected, result.Errors) {
+		t.Fatalf("Unexpected errors with fragments, Diff: %v", testutil.Diff(expected, result.Errors))
+	}
+}
+
+func BenchmarkValidator_VariableRulesWithoutFragments(b *testing.B) {
+	query := "query Q($show: Boolean) {\n"
+	for i := 0; i < 100; i++ {
+		query += "  dog { name @include(if: $show) owner { name } }\n"
+	}
+	query += "}\n"
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		b.Fatal(err)
+	}
+	rules := []graphql.ValidationRuleFn{
+		graphql.NoUndefinedVariablesRule,
+		graphql.NoUnusedVariablesRule,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		graphql.ValidateDocument(testutil.TestSchema, doc, rules)
+	}
+}
+
+func TestValidator_ReportErrorFromGoroutines(t *testing.T) {
+	doc := testutil.TestParse(t, `{ dog { name nickname barkVolume } human { name } }`)
+	concurrentRule := func(context *graphql.ValidationContext) *graphql.ValidationRuleInstance {
+		return &graphql.ValidationRuleInstance{
+			VisitorOpts: &visitor.VisitorOptions{
+				KindFuncMap: map[string]visitor.NamedVisitFuncs{
+					kinds.Field: {
+						Kind: func(p visitor.VisitFuncParams) (string, interface{}) {
+							node := p.Node.(*ast.Field)
+							var wg sync.WaitGroup
+							for i := 0; i < 10; i++ {
+								wg.Add(1)
+								go func(i int) {
+									defer wg.Done()
+									message := fmt.Sprintf("Field %v, check %v.", node.Name.Value, i)
+									if i%2 == 0 {
+										context.ReportValidationError(message, []ast.Node{node})
+									} else {
+										context.ReportWarning(gqlerrors.NewError(message, []ast.Node{node}, "", nil, []int{}, nil))
+									}
+								}(i)
+							}
+							wg.Wait()
+							return visitor.ActionNoChange, nil
+						},
+					},
+				},
+			},
+		}
+	}
+	result := graphql.ValidateDocument(testutil.TestSchema, doc, []graphql.ValidationRuleFn{concurrentRule})
+	// Six fields, each reporting five errors and five warnings.
+	if len(result.Errors) != 30 {
+		t.Fatalf("Expected 30 errors, got: %v", len(result.Errors))
+	}
+	if len(result.Warnings) != 30 {
+		t.Fatalf("Expected 30 warnings, got: %v", len(result.Warnings))
+	}
+}
+
+func TestValidateDocumentWithOptions_TypeResolver(t *testing.T) {
+	doc := testutil.TestParse(t, `
+      query Query($filter: Filter) {
+        dog {
+          name
+        }
+      }
+    `)
+	rules := []graphql.ValidationRuleFn{
+		graphql.KnownTypeNamesRule,
+		graphql.VariablesAreInputTypesRule,
+	}
+
+	result := graphql.ValidateDocumentWithOptions(testutil.TestSchema, doc, rules, nil)
+	if result.IsValid {
+		t.Fatalf("Expected Filter to be unknown without a type resolver")
+	}
+
+	// Filter isn't in the schema's type map, it is only built on demand.
+	var filter graphql.Type
+	resolved := []string{}
+	result = graphql.ValidateDocumentWithOptions(testutil.TestSchema, doc, rules, &graphql.ValidationOptions{
+		TypeResolver: func(name string) graphql.Type {
+			resolved = append(resolved, name)
+			if name != "Filter" {
+				return nil
+			}
+			if filter == nil {
+				filter = graphql.NewInputObject(graphql.InputObjectConfig{
+					Name: "Filter",
+					Fields: graphql.InputObjectConfigFieldMap{
+						"name": &graphql.InputObjectFieldConfig{Type: graphql.String},
+					},
+				})
+			}
+			return filter
+		},
+	})
+	if !result.IsValid {
+		t.Fatalf("Expected the document to be valid, got: %v", result.Errors)
+	}
+	if len(resolved) == 0 {
+		t.Fatalf("Expected the type resolver to be called")
+	}
+	for _, name := range resolved {
+		if name != "Filter" {
+			t.Fatalf("Expected only Filter to be resolved, got: %v", resolved)
+		}
+	}
+}
@@ -182,15 +182,22 @@ func coerceValue(ttype Input, value interface{}) interface{} {
 // TODO: figure out where to organize utils
 // TODO: change to *Schema
 func typeFromAST(schema Schema, inputTypeAST ast.Type) (Type, error) {
+	return typeFromASTWithResolver(schema.Type, inputTypeAST)
+}
+
+// typeFromASTWithResolver Like typeFromAST, but resolves named types with the
+// given resolver instead of a schema's type map, so that the types of a
+// lazily built schema can be materialized on demand.
+func typeFromASTWithResolver(resolver func(name string) Type, inputTypeAST ast.Type) (Type, error) {
 	switch inputTypeAST := inputTypeAST.(type) {
 	case *ast.List:
-		innerType, err := typeFromAST(schema, inputTypeAST.Type)
+		innerType, err := typeFromASTWithResolver(resolver, inputTypeAST.Type)
 		if err != nil {
 			return nil, err
 		}
 		return NewList(innerType), nil
 	case *ast.NonNull:
-		innerType, err := typeFromAST(schema, inputTypeAST.Type)
+		innerType, err := typeFromASTWithResolver(resolver, inputTypeAST.Type)
 		if err != nil {
 			return nil, err
 		}
@@ -200,13 +207,29 @@ func typeFromAST(schema Schema, inputTypeAST ast.Type) (Type, error) {
 		if inputTypeAST.Name != nil {
 			nameValue = inputTypeAST.Name.Value
 		}
-		ttype := schema.Type(nameValue)
+		ttype := resolver(nameValue)
 		return ttype, nil
 	default:
 		return nil, invariant(inputTypeAST.GetKind() == kinds.Named, "Must be a named type.")
 	}
 }
 
+// schemaTypeResolver Returns a resolver which looks the named types up in the
+// schema's type map, and then with the fallback resolver, if any.
+func schemaTypeResolver(schema *Schema, fallback func(name string) Type) func(name string) Type {
+	return func(name string) Type {
+		if schema != nil {
+			if ttype := schema.Type(name); ttype != nil {
+				return ttype
+			}
+		}
+		if fallback != nil {
+			return fallback(name)
+		}
+		return nil
+	}
+}
+
 // isValidInputValue alias isValidJSValue
 // Given a value and a GraphQL type, determine if the value will be
 // accepted for that type. This is primarily useful for validating the
@@ -214,10 +237,7 @@ func typeFromAST(schema Schema, inputTypeAST ast.Type) (Type, error) {
 func isValidInputValue(value interface{}, ttype Input) (bool, []string) {
 	if isNullish(value) {
 		if ttype, ok := ttype.(*NonNull); ok {
-			if ttype.OfType.Name() != "" {
-				return false, []string{fmt.Sprintf(`Expected "%v!", found null.`, ttype.OfType.Name())}
-			}
-			return false, []string{"Expected non-null value, found null."}
+			return false, []string{fmt.Sprintf(`Expected "%v", found null.`, ttype)}
 		}
 		return true, nil
 	}
@@ -234,8 +254,8 @@ func isValidInputValue(value interface{}, ttype Input) (bool, []string) {
 			for i := 0; i < valType.Len(); i++ {
 				val := valType.Index(i).Interface()
 				_, messages := isValidInputValue(val, ttype.OfType)
-				for idx, message := range messages {
-					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, idx+1, message))
+				for _, message := range messages {
+					messagesReduce = append(messagesReduce, fmt.Sprintf(`In element #%v: %v`, i+1, message))
 				}
 			}
 			return (len(messagesReduce) == 0), messagesReduce